@@ -0,0 +1,18 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "spiderctl",
+	Short: "spiderctl is a command-line tool for operating a Spiderpool cluster",
+}
+
+// Execute runs the root spiderctl command.
+func Execute() error {
+	return rootCmd.Execute()
+}
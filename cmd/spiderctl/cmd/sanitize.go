@@ -0,0 +1,98 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	"github.com/spidernet-io/spiderpool/pkg/ippoolmanager"
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+	"github.com/spidernet-io/spiderpool/pkg/sanitizer"
+	"github.com/spidernet-io/spiderpool/pkg/subnetmanager"
+	"github.com/spidernet-io/spiderpool/pkg/workloadendpointmanager"
+)
+
+var sanitizeOutputFormat string
+
+func init() {
+	rootCmd.AddCommand(NewSanitizeCommand())
+}
+
+// NewSanitizeCommand builds the `spiderctl sanitize` subcommand: a one-shot
+// scan of the live cluster for Spiderpool-specific misconfigurations.
+func NewSanitizeCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "sanitize",
+		Short: "Scan the cluster for Spiderpool-specific misconfigurations",
+		RunE:  runSanitize,
+	}
+
+	command.Flags().StringVarP(&sanitizeOutputFormat, "output", "o", "table", "output format: table, json, or yaml")
+
+	return command
+}
+
+func runSanitize(_ *cobra.Command, _ []string) error {
+	restConfig, err := config.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return fmt.Errorf("failed to register built-in types: %w", err)
+	}
+	if err := spiderpoolv2beta1.AddToScheme(scheme); err != nil {
+		return fmt.Errorf("failed to register Spiderpool CRDs: %w", err)
+	}
+
+	c, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("failed to build client: %w", err)
+	}
+
+	podMgr, err := podmanager.NewPodManager(podmanager.PodManagerConfig{}, c)
+	if err != nil {
+		return err
+	}
+
+	poolMgr, err := ippoolmanager.NewIPPoolManager(ippoolmanager.IPPoolManagerConfig{}, c)
+	if err != nil {
+		return err
+	}
+
+	subnetMgr, err := subnetmanager.NewSubnetManager(subnetmanager.SubnetManagerConfig{}, c)
+	if err != nil {
+		return err
+	}
+
+	endpointMgr, err := workloadendpointmanager.NewWorkloadEndpointManager(workloadendpointmanager.WorkloadEndpointManagerConfig{}, c)
+	if err != nil {
+		return err
+	}
+
+	s := sanitizer.NewSanitizer(
+		&sanitizer.PodIPPoolAnnotationCheck{PodManager: podMgr, IPPoolManager: poolMgr},
+		&sanitizer.OrphanedEndpointCheck{PodManager: podMgr, WorkloadEndpointManager: endpointMgr},
+		&sanitizer.EmptyPoolSelectorCheck{PodManager: podMgr, IPPoolManager: poolMgr, Client: c},
+		&sanitizer.StuckLifecycleCheck{PodManager: podMgr},
+		&sanitizer.DeadAutoSubnetCheck{PodManager: podMgr, SubnetManager: subnetMgr},
+	)
+
+	report, err := s.Run(context.Background())
+	if err != nil {
+		return err
+	}
+
+	return sanitizer.WriteReport(os.Stdout, report, sanitizer.Format(sanitizeOutputFormat))
+}
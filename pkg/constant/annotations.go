@@ -0,0 +1,14 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package constant
+
+// Pod annotations that pin IPAM requests to specific SpiderIPPools/
+// SpiderSubnets. The single-NIC keys hold one JSON object, the multi-NIC
+// keys hold a JSON array of the same object, one per attached interface.
+const (
+	AnnotationPodIPPool  = "ipam.spidernet.io/ippool"
+	AnnotationPodIPPools = "ipam.spidernet.io/ippools"
+	AnnotationSubnet     = "ipam.spidernet.io/subnet"
+	AnnotationSubnets    = "ipam.spidernet.io/subnets"
+)
@@ -8,9 +8,13 @@ import (
 )
 
 var (
-	ErrInternal          = errors.New("internal server error")
-	ErrWrongInput        = errors.New("wrong input information")
-	ErrNotAllocatablePod = errors.New("not allocatable pod")
-	ErrNoAvailablePool   = errors.New("no available IP pool")
-	ErrIPUsedOut         = errors.New("all IP used out")
+	ErrInternal             = errors.New("internal server error")
+	ErrWrongInput           = errors.New("wrong input information")
+	ErrNotAllocatablePod    = errors.New("not allocatable pod")
+	ErrNoAvailablePool      = errors.New("no available IP pool")
+	ErrIPUsedOut            = errors.New("all IP used out")
+	ErrMissingRequiredParam = errors.New("missing required parameter")
+	ErrRetriesExhausted     = errors.New("running out of retries")
+	ErrUnknown              = errors.New("unknown error")
+	ErrLifecycleBlocked     = errors.New("pod is in an ops lifecycle owned by another operation")
 )
@@ -0,0 +1,18 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package constant
+
+// Pod annotations that make up the ops lifecycle handoff protocol (see
+// pkg/podopslifecycle). A Pod carries at most one of the three phase
+// annotations at a time; prepare-delete and operating hold the requesting
+// operation's name (e.g. "Delete"), completed holds the finished opsID:
+//
+//	prepare-delete -> operating -> completed
+const (
+	AnnotationPrepareDelete = "spiderpool.spidernet.io/prepare-delete"
+	AnnotationOperating     = "spiderpool.spidernet.io/operating"
+	AnnotationCompleted     = "spiderpool.spidernet.io/completed"
+	AnnotationOpsID         = "spiderpool.spidernet.io/ops-id"
+	AnnotationOpsStartTime  = "spiderpool.spidernet.io/ops-start-time"
+)
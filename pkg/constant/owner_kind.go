@@ -0,0 +1,32 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package constant
+
+// Kind strings for the built-in workload controllers that
+// PodManager.GetPodTopController knows how to resolve via the typed client.
+const (
+	KindPod         = "Pod"
+	KindReplicaSet  = "ReplicaSet"
+	KindDeployment  = "Deployment"
+	KindStatefulSet = "StatefulSet"
+	KindDaemonSet   = "DaemonSet"
+	KindJob         = "Job"
+	KindCronJob     = "CronJob"
+)
+
+// OwnerKindAllowList enumerates the owner-reference kinds that
+// PodManager.GetPodTopController fetches as typed objects instead of falling
+// back to an unstructured.Unstructured lookup. Kinds outside this list are
+// assumed to be third-party or CRD-defined controllers. It is the
+// authoritative list other packages (e.g. pkg/sanitizer) should consult when
+// they need to know which owner kinds Spiderpool understands; pkg/podmanager
+// keeps it in sync with its internal typed-object factories.
+var OwnerKindAllowList = map[string]struct{}{
+	KindReplicaSet:  {},
+	KindDeployment:  {},
+	KindStatefulSet: {},
+	KindDaemonSet:   {},
+	KindJob:         {},
+	KindCronJob:     {},
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package ippoolmanager
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+)
+
+// IPPoolManager exposes read operations against the SpiderIPPool API that
+// the rest of Spiderpool relies on, hiding the underlying controller-runtime
+// client.
+type IPPoolManager interface {
+	ListIPPools(ctx context.Context, opts ...client.ListOption) (*spiderpoolv2beta1.SpiderIPPoolList, error)
+}
+
+// IPPoolManagerConfig holds tunables for IPPoolManager. It is currently
+// empty; it exists so NewIPPoolManager matches the constructor shape of the
+// rest of Spiderpool's managers.
+type IPPoolManagerConfig struct{}
+
+type ipPoolManager struct {
+	config IPPoolManagerConfig
+	client client.Client
+}
+
+func NewIPPoolManager(c IPPoolManagerConfig, client client.Client) (IPPoolManager, error) {
+	if client == nil {
+		return nil, constant.ErrMissingRequiredParam
+	}
+
+	return &ipPoolManager{
+		config: c,
+		client: client,
+	}, nil
+}
+
+func (im *ipPoolManager) ListIPPools(ctx context.Context, opts ...client.ListOption) (*spiderpoolv2beta1.SpiderIPPoolList, error) {
+	var poolList spiderpoolv2beta1.SpiderIPPoolList
+	if err := im.client.List(ctx, &poolList, opts...); err != nil {
+		return nil, err
+	}
+
+	return &poolList, nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+// Package v2beta1 contains API Schema definitions for the
+// spiderpool.spidernet.io v2beta1 API group.
+// +kubebuilder:object:generate=true
+// +groupName=spiderpool.spidernet.io
+package v2beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "spiderpool.spidernet.io", Version: "v2beta1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+func init() {
+	SchemeBuilder.Register(&SpiderReport{}, &SpiderReportList{})
+	SchemeBuilder.Register(&SpiderIPPool{}, &SpiderIPPoolList{})
+	SchemeBuilder.Register(&SpiderEndpoint{}, &SpiderEndpointList{})
+	SchemeBuilder.Register(&SpiderSubnet{}, &SpiderSubnetList{})
+}
@@ -0,0 +1,33 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package v2beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpiderEndpointSpec is empty: a SpiderEndpoint's name/namespace already
+// identify the Pod it describes.
+type SpiderEndpointSpec struct{}
+
+// +kubebuilder:object:root=true
+
+// SpiderEndpoint records the IP allocations made to a single Pod, named and
+// namespaced to match that Pod. It outlives the Pod if nothing reclaims it,
+// which is what OrphanedEndpointCheck looks for.
+type SpiderEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SpiderEndpointSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpiderEndpointList contains a list of SpiderEndpoint.
+type SpiderEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpiderEndpoint `json:"items"`
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package v2beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpiderIPPoolSpec configures which Pods a SpiderIPPool is reserved for.
+type SpiderIPPoolSpec struct {
+	// PodAffinity restricts the pool to Pods matching this label selector.
+	// A nil PodAffinity places no restriction on Pod labels.
+	PodAffinity *metav1.LabelSelector `json:"podAffinity,omitempty"`
+
+	// NamespaceAffinity restricts the pool to Pods living in Namespaces
+	// matching this label selector. A nil NamespaceAffinity places no
+	// restriction on Namespace.
+	NamespaceAffinity *metav1.LabelSelector `json:"namespaceAffinity,omitempty"`
+
+	// NamespaceName restricts the pool to a single Namespace by name.
+	NamespaceName string `json:"namespaceName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// SpiderIPPool is a cluster-scoped reservation of IP addresses Spiderpool
+// hands out to Pods whose affinity matches the pool.
+type SpiderIPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SpiderIPPoolSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpiderIPPoolList contains a list of SpiderIPPool.
+type SpiderIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpiderIPPool `json:"items"`
+}
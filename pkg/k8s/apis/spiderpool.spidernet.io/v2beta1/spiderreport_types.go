@@ -0,0 +1,52 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package v2beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpiderReportSpec is empty: a SpiderReport is a point-in-time snapshot
+// PeriodicRunner writes, not something an operator configures.
+type SpiderReportSpec struct{}
+
+// SpiderReportFinding mirrors sanitizer.Finding, duplicated here so this
+// package does not import pkg/sanitizer.
+type SpiderReportFinding struct {
+	Check     string `json:"check"`
+	Severity  string `json:"severity"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Message   string `json:"message"`
+}
+
+// SpiderReportStatus holds the most recent sanitizer Report for the cluster.
+type SpiderReportStatus struct {
+	Findings    []SpiderReportFinding `json:"findings,omitempty"`
+	Score       int                   `json:"score"`
+	LastUpdated metav1.Time           `json:"lastUpdated,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// SpiderReport is the cluster-scoped record of the most recent Spiderpool
+// sanitizer run, written by pkg/sanitizer.PeriodicRunner.
+type SpiderReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpiderReportSpec   `json:"spec,omitempty"`
+	Status SpiderReportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpiderReportList contains a list of SpiderReport.
+type SpiderReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpiderReport `json:"items"`
+}
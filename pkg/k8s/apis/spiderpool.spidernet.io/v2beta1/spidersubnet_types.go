@@ -0,0 +1,36 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package v2beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpiderSubnetSpec describes the IP range a SpiderSubnet carves auto-pools
+// out of.
+type SpiderSubnetSpec struct {
+	// Subnet is the CIDR this SpiderSubnet manages.
+	Subnet string `json:"subnet,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// SpiderSubnet is a cluster-scoped pool of IP addresses Spiderpool carves
+// auto-pools out of on a top controller's behalf.
+type SpiderSubnet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SpiderSubnetSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpiderSubnetList contains a list of SpiderSubnet.
+type SpiderSubnetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpiderSubnet `json:"items"`
+}
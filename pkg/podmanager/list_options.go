@@ -0,0 +1,196 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanager
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListOptions collects the filters a ListOption applies to ListPods. Its
+// zero value lists every Pod in the cluster.
+type ListOptions struct {
+	namespace     string
+	labelSelector labels.Selector
+	fieldSelector fields.Selector
+	ownerKind     *schema.GroupKind
+	topController client.Object
+	err           error
+}
+
+// ListOption mutates a ListOptions. Functional options that can fail (e.g.
+// WithLabelSelector on an invalid selector) record the error on the
+// ListOptions instead of returning one themselves; ListPods surfaces it.
+type ListOption func(*ListOptions)
+
+// WithNamespace restricts the list to namespace. The zero value lists across
+// all namespaces.
+func WithNamespace(namespace string) ListOption {
+	return func(o *ListOptions) { o.namespace = namespace }
+}
+
+// WithLabels restricts the list to Pods matching every key/value in set.
+func WithLabels(set map[string]string) ListOption {
+	return func(o *ListOptions) { o.labelSelector = labels.SelectorFromValidatedSet(set) }
+}
+
+// WithLabelSelector restricts the list to Pods matching selector, including
+// its MatchExpressions.
+func WithLabelSelector(selector *metav1.LabelSelector) ListOption {
+	return func(o *ListOptions) {
+		if selector == nil {
+			return
+		}
+
+		s, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			o.err = err
+			return
+		}
+		o.labelSelector = s
+	}
+}
+
+// WithFields restricts the list to Pods matching selector.
+func WithFields(selector fields.Selector) ListOption {
+	return func(o *ListOptions) { o.fieldSelector = mergeFieldSelector(o.fieldSelector, selector) }
+}
+
+// WithNodeName restricts the list to Pods scheduled onto the named Node.
+func WithNodeName(name string) ListOption {
+	return func(o *ListOptions) {
+		o.fieldSelector = mergeFieldSelector(o.fieldSelector, fields.OneTermEqualSelector("spec.nodeName", name))
+	}
+}
+
+// WithPhase restricts the list to Pods currently in phase.
+func WithPhase(phase corev1.PodPhase) ListOption {
+	return func(o *ListOptions) {
+		o.fieldSelector = mergeFieldSelector(o.fieldSelector, fields.OneTermEqualSelector("status.phase", string(phase)))
+	}
+}
+
+// WithOwnerKind restricts the list to Pods whose direct controller owner
+// reference is of the given GroupKind.
+func WithOwnerKind(gk schema.GroupKind) ListOption {
+	return func(o *ListOptions) { o.ownerKind = &gk }
+}
+
+// WithTopController restricts the list to Pods whose
+// PodManager.GetPodTopController resolves to obj. Because resolving the top
+// controller may require several Get calls per Pod, prefer pairing it with a
+// narrowing option like WithNamespace or WithLabels.
+func WithTopController(obj client.Object) ListOption {
+	return func(o *ListOptions) { o.topController = obj }
+}
+
+// MustParseSelector parses a label selector string (e.g. "foo=bar,env!=prod")
+// and panics if it is invalid. It exists for call sites building a selector
+// from a compile-time-constant string, where an invalid selector is a
+// programmer error rather than something to handle gracefully.
+func MustParseSelector(selector string) labels.Selector {
+	s, err := labels.Parse(selector)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func mergeFieldSelector(existing, add fields.Selector) fields.Selector {
+	if existing == nil {
+		return add
+	}
+	return fields.AndSelectors(existing, add)
+}
+
+func (pm *podManager) ListPods(ctx context.Context, opts ...ListOption) (*corev1.PodList, error) {
+	options := &ListOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.err != nil {
+		return nil, options.err
+	}
+
+	var clientOpts []client.ListOption
+	if options.namespace != "" {
+		clientOpts = append(clientOpts, client.InNamespace(options.namespace))
+	}
+	if options.labelSelector != nil {
+		clientOpts = append(clientOpts, client.MatchingLabelsSelector{Selector: options.labelSelector})
+	}
+	if options.fieldSelector != nil {
+		clientOpts = append(clientOpts, client.MatchingFieldsSelector{Selector: options.fieldSelector})
+	}
+
+	podList, err := pm.ListPodsRaw(ctx, clientOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.ownerKind == nil && options.topController == nil {
+		return podList, nil
+	}
+
+	filtered := podList.DeepCopy()
+	filtered.Items = filtered.Items[:0]
+
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+
+		if options.ownerKind != nil && !matchesOwnerKind(pod, *options.ownerKind) {
+			continue
+		}
+
+		if options.topController != nil {
+			top, err := pm.GetPodTopController(ctx, pod)
+			if err != nil {
+				return nil, err
+			}
+			if !matchesTopController(pm.client, top, options.topController) {
+				continue
+			}
+		}
+
+		filtered.Items = append(filtered.Items, *pod)
+	}
+
+	return filtered, nil
+}
+
+// matchesTopController reports whether top, a resolved PodTopController,
+// is the same object as want: same name/namespace, and the same kind so
+// that e.g. a Deployment and a StatefulSet sharing a name don't cross-match.
+func matchesTopController(c client.Client, top PodTopController, want client.Object) bool {
+	if top.Object.GetName() != want.GetName() || top.Object.GetNamespace() != want.GetNamespace() {
+		return false
+	}
+
+	gvks, _, err := c.Scheme().ObjectKinds(want)
+	if err != nil || len(gvks) == 0 {
+		return false
+	}
+
+	return top.Kind == gvks[0].Kind
+}
+
+func matchesOwnerKind(pod *corev1.Pod, gk schema.GroupKind) bool {
+	ownerRef := metav1.GetControllerOfNoCopy(pod)
+	if ownerRef == nil {
+		return false
+	}
+
+	gv, err := schema.ParseGroupVersion(ownerRef.APIVersion)
+	if err != nil {
+		return false
+	}
+
+	return gv.Group == gk.Group && ownerRef.Kind == gk.Kind
+}
@@ -0,0 +1,26 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanager
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+)
+
+// ownerKindFactories must track constant.OwnerKindAllowList exactly: every
+// kind Spiderpool claims to know about needs a typed-object factory, and
+// every factory must be for a kind Spiderpool claims to know about.
+var _ = Describe("ownerKindFactories", Label("owner_kind_factories_test"), func() {
+	It("has exactly one factory per kind in constant.OwnerKindAllowList", func() {
+		for kind := range constant.OwnerKindAllowList {
+			Expect(ownerKindFactories).To(HaveKey(kind))
+		}
+
+		for kind := range ownerKindFactories {
+			Expect(constant.OwnerKindAllowList).To(HaveKey(kind))
+		}
+	})
+})
@@ -0,0 +1,363 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanager
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+)
+
+const (
+	defaultMaxConflictRetries    = 3
+	defaultConflictRetryUnitTime = 100 * time.Millisecond
+	defaultOpsLifecycleTimeout   = 2 * time.Minute
+)
+
+// PodManager exposes read/write operations against the Pod API that the rest
+// of Spiderpool relies on, hiding the underlying controller-runtime client.
+type PodManager interface {
+	GetPodByName(ctx context.Context, namespace, podName string, opts ...client.GetOption) (*corev1.Pod, error)
+	ListPods(ctx context.Context, opts ...ListOption) (*corev1.PodList, error)
+	// ListPodsRaw is the pre-refactor ListPods signature, kept for one
+	// release while callers migrate to the typed ListOption API.
+	ListPodsRaw(ctx context.Context, opts ...client.ListOption) (*corev1.PodList, error)
+	MatchLabelSelector(ctx context.Context, namespace, podName string, labelSelector *metav1.LabelSelector) (bool, error)
+	MergeAnnotations(ctx context.Context, namespace, podName string, annotations map[string]string) error
+	GetPodTopController(ctx context.Context, pod *corev1.Pod) (PodTopController, error)
+	BeginOpsLifecycle(ctx context.Context, namespace, podName, opsID, ops string) error
+	CompleteOpsLifecycle(ctx context.Context, namespace, podName, opsID string) error
+}
+
+// PodTopController is the highest-level owner a Pod can be traced back to
+// through its chain of controller OwnerReferences. Kind is a bare kind
+// string (not a GroupVersionKind) because the owning object itself already
+// carries its TypeMeta/GVK once fetched.
+type PodTopController struct {
+	Kind   string
+	Object client.Object
+}
+
+// PodManagerConfig holds tunables for PodManager. Zero values are replaced by
+// sane defaults in NewPodManager.
+type PodManagerConfig struct {
+	MaxConflictRetries    int
+	ConflictRetryUnitTime time.Duration
+
+	// OpsLifecycleTimeout bounds how long a Pod may sit in an ops lifecycle
+	// before BeginOpsLifecycle allows a different opsID to reclaim it.
+	OpsLifecycleTimeout time.Duration
+}
+
+func (c *PodManagerConfig) setDefaults() {
+	if c.MaxConflictRetries == 0 {
+		c.MaxConflictRetries = defaultMaxConflictRetries
+	}
+	if c.ConflictRetryUnitTime == 0 {
+		c.ConflictRetryUnitTime = defaultConflictRetryUnitTime
+	}
+	if c.OpsLifecycleTimeout == 0 {
+		c.OpsLifecycleTimeout = defaultOpsLifecycleTimeout
+	}
+}
+
+type podManager struct {
+	config PodManagerConfig
+	client client.Client
+}
+
+func NewPodManager(c PodManagerConfig, client client.Client) (PodManager, error) {
+	if client == nil {
+		return nil, constant.ErrMissingRequiredParam
+	}
+
+	c.setDefaults()
+
+	return &podManager{
+		config: c,
+		client: client,
+	}, nil
+}
+
+func (pm *podManager) GetPodByName(ctx context.Context, namespace, podName string, opts ...client.GetOption) (*corev1.Pod, error) {
+	var pod corev1.Pod
+	if err := pm.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, &pod, opts...); err != nil {
+		return nil, err
+	}
+
+	return &pod, nil
+}
+
+func (pm *podManager) ListPodsRaw(ctx context.Context, opts ...client.ListOption) (*corev1.PodList, error) {
+	var podList corev1.PodList
+	if err := pm.client.List(ctx, &podList, opts...); err != nil {
+		return nil, err
+	}
+
+	return &podList, nil
+}
+
+func (pm *podManager) MatchLabelSelector(ctx context.Context, namespace, podName string, labelSelector *metav1.LabelSelector) (bool, error) {
+	if labelSelector == nil {
+		return false, nil
+	}
+
+	podList, err := pm.ListPods(ctx, WithNamespace(namespace), WithLabelSelector(labelSelector))
+	if err != nil {
+		return false, err
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Name == podName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (pm *podManager) MergeAnnotations(ctx context.Context, namespace, podName string, annotations map[string]string) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	backoff := wait.Backoff{
+		Steps:    pm.config.MaxConflictRetries,
+		Duration: pm.config.ConflictRetryUnitTime,
+		Factor:   1,
+	}
+
+	if err := retry.OnError(backoff, apierrors.IsConflict, func() error {
+		pod, err := pm.GetPodByName(ctx, namespace, podName)
+		if err != nil {
+			return err
+		}
+
+		merged := pod.GetAnnotations()
+		if merged == nil {
+			merged = make(map[string]string, len(annotations))
+		}
+		for k, v := range annotations {
+			merged[k] = v
+		}
+		pod.SetAnnotations(merged)
+
+		return pm.client.Update(ctx, pod)
+	}); err != nil {
+		if apierrors.IsConflict(err) {
+			return constant.ErrRetriesExhausted
+		}
+		return err
+	}
+
+	return nil
+}
+
+// BeginOpsLifecycle stamps pod into the prepare-delete phase of an ops
+// lifecycle on behalf of opsID, recording ops (e.g. "Delete", "Update") as
+// the reason. It is re-entrant: calling it again with the same opsID is a
+// no-op success, whether the Pod is still in prepare-delete or has already
+// advanced to operating/completed -- it never regresses a Pod to an earlier
+// phase. If another opsID already owns an in-flight lifecycle on the Pod, it
+// returns constant.ErrLifecycleBlocked unless that lifecycle has exceeded
+// PodManagerConfig.OpsLifecycleTimeout, in which case it is reclaimed.
+func (pm *podManager) BeginOpsLifecycle(ctx context.Context, namespace, podName, opsID, ops string) error {
+	if opsID == "" || ops == "" {
+		return constant.ErrWrongInput
+	}
+
+	backoff := wait.Backoff{
+		Steps:    pm.config.MaxConflictRetries,
+		Duration: pm.config.ConflictRetryUnitTime,
+		Factor:   1,
+	}
+
+	if err := retry.OnError(backoff, apierrors.IsConflict, func() error {
+		pod, err := pm.GetPodByName(ctx, namespace, podName)
+		if err != nil {
+			return err
+		}
+
+		annotations := pod.GetAnnotations()
+		activeID, hasActiveID := annotations[constant.AnnotationOpsID]
+		if hasActiveID && activeID != opsID && !pm.isOpsLifecycleExpired(annotations) {
+			return constant.ErrLifecycleBlocked
+		}
+
+		if hasActiveID && activeID == opsID && (annotations[constant.AnnotationOperating] != "" || annotations[constant.AnnotationCompleted] != "") {
+			// Already advanced past prepare-delete for this same opsID:
+			// leave it alone rather than regressing the phase.
+			return nil
+		}
+		if annotations[constant.AnnotationCompleted] == opsID {
+			// CompleteOpsLifecycle already finished this same opsID (and
+			// cleared the ops-id annotation): don't restart it.
+			return nil
+		}
+
+		if annotations == nil {
+			annotations = make(map[string]string, 3)
+		}
+		annotations[constant.AnnotationPrepareDelete] = ops
+		annotations[constant.AnnotationOpsID] = opsID
+		annotations[constant.AnnotationOpsStartTime] = time.Now().Format(time.RFC3339Nano)
+		delete(annotations, constant.AnnotationOperating)
+		delete(annotations, constant.AnnotationCompleted)
+		pod.SetAnnotations(annotations)
+
+		return pm.client.Update(ctx, pod)
+	}); err != nil {
+		if apierrors.IsConflict(err) {
+			return constant.ErrRetriesExhausted
+		}
+		return err
+	}
+
+	return nil
+}
+
+// CompleteOpsLifecycle marks the ops lifecycle opsID started on pod as
+// completed and clears the rest of the lifecycle annotations. It is a no-op
+// if the Pod is not currently in a lifecycle, and returns
+// constant.ErrLifecycleBlocked if the in-flight lifecycle belongs to a
+// different opsID.
+func (pm *podManager) CompleteOpsLifecycle(ctx context.Context, namespace, podName, opsID string) error {
+	backoff := wait.Backoff{
+		Steps:    pm.config.MaxConflictRetries,
+		Duration: pm.config.ConflictRetryUnitTime,
+		Factor:   1,
+	}
+
+	if err := retry.OnError(backoff, apierrors.IsConflict, func() error {
+		pod, err := pm.GetPodByName(ctx, namespace, podName)
+		if err != nil {
+			return err
+		}
+
+		annotations := pod.GetAnnotations()
+		activeID, inLifecycle := annotations[constant.AnnotationOpsID]
+		if !inLifecycle {
+			return nil
+		}
+		if activeID != opsID {
+			return constant.ErrLifecycleBlocked
+		}
+
+		delete(annotations, constant.AnnotationPrepareDelete)
+		delete(annotations, constant.AnnotationOperating)
+		delete(annotations, constant.AnnotationOpsID)
+		delete(annotations, constant.AnnotationOpsStartTime)
+		annotations[constant.AnnotationCompleted] = opsID
+		pod.SetAnnotations(annotations)
+
+		return pm.client.Update(ctx, pod)
+	}); err != nil {
+		if apierrors.IsConflict(err) {
+			return constant.ErrRetriesExhausted
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (pm *podManager) isOpsLifecycleExpired(annotations map[string]string) bool {
+	startedAt, ok := annotations[constant.AnnotationOpsStartTime]
+	if !ok {
+		return true
+	}
+
+	start, err := time.Parse(time.RFC3339Nano, startedAt)
+	if err != nil {
+		return true
+	}
+
+	return time.Since(start) > pm.config.OpsLifecycleTimeout
+}
+
+// hopThroughKinds are owner kinds GetPodTopController keeps climbing past in
+// search of a higher controller, because Spiderpool knows a further owner
+// (Deployment, CronJob) may sit above them.
+var hopThroughKinds = map[string]struct{}{
+	constant.KindReplicaSet: {},
+	constant.KindJob:        {},
+}
+
+func (pm *podManager) GetPodTopController(ctx context.Context, pod *corev1.Pod) (PodTopController, error) {
+	if pod == nil {
+		return PodTopController{}, constant.ErrWrongInput
+	}
+
+	kind := constant.KindPod
+	var obj client.Object = pod
+
+	for {
+		ownerRef := metav1.GetControllerOfNoCopy(obj)
+		if ownerRef == nil {
+			break
+		}
+
+		owner, err := pm.fetchOwner(ctx, pod.Namespace, *ownerRef)
+		if err != nil {
+			return PodTopController{}, err
+		}
+
+		kind = ownerRef.Kind
+		obj = owner
+
+		if _, hopThrough := hopThroughKinds[kind]; !hopThrough {
+			break
+		}
+	}
+
+	return PodTopController{Kind: kind, Object: obj}, nil
+}
+
+// ownerKindFactories backs constant.OwnerKindAllowList with the typed object
+// each allow-listed kind actually fetches as; a kind missing here is assumed
+// to be a third-party or CRD-defined controller and falls back to
+// unstructured.Unstructured. Its key set must match constant.OwnerKindAllowList
+// exactly -- see the guard test in owner_kind_factories_test.go.
+var ownerKindFactories = map[string]func() client.Object{
+	constant.KindReplicaSet:  func() client.Object { return &appsv1.ReplicaSet{} },
+	constant.KindDeployment:  func() client.Object { return &appsv1.Deployment{} },
+	constant.KindStatefulSet: func() client.Object { return &appsv1.StatefulSet{} },
+	constant.KindDaemonSet:   func() client.Object { return &appsv1.DaemonSet{} },
+	constant.KindJob:         func() client.Object { return &batchv1.Job{} },
+	constant.KindCronJob:     func() client.Object { return &batchv1.CronJob{} },
+}
+
+// fetchOwner resolves an OwnerReference to a real object. Kinds in
+// ownerKindFactories are fetched as their typed object; anything else is
+// fetched generically via unstructured.Unstructured so the caller still gets
+// back a real, live resource rather than an OwnerReference stub.
+func (pm *podManager) fetchOwner(ctx context.Context, namespace string, ref metav1.OwnerReference) (client.Object, error) {
+	var obj client.Object
+	if factory, ok := ownerKindFactories[ref.Kind]; ok {
+		obj = factory()
+	} else {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(ref.APIVersion)
+		u.SetKind(ref.Kind)
+		obj = u
+	}
+
+	if err := pm.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
@@ -7,13 +7,19 @@ import (
 	"context"
 	"fmt"
 	"sync/atomic"
+	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
@@ -137,7 +143,7 @@ var _ = Describe("PodManager", Label("pod_manager_test"), func() {
 				err := fakeClient.Create(ctx, podT)
 				Expect(err).NotTo(HaveOccurred())
 
-				podList, err := podManager.ListPods(ctx, client.InNamespace(namespace))
+				podList, err := podManager.ListPods(ctx, podmanager.WithNamespace(namespace))
 				Expect(err).NotTo(HaveOccurred())
 				Expect(podList.Items).NotTo(BeEmpty())
 
@@ -151,12 +157,12 @@ var _ = Describe("PodManager", Label("pod_manager_test"), func() {
 				Expect(hasPod).To(BeTrue())
 			})
 
-			It("filters results by label selector", func() {
+			It("filters results by labels", func() {
 				ctx := context.TODO()
 				err := fakeClient.Create(ctx, podT)
 				Expect(err).NotTo(HaveOccurred())
 
-				podList, err := podManager.ListPods(ctx, client.MatchingLabels(labels))
+				podList, err := podManager.ListPods(ctx, podmanager.WithLabels(labels))
 				Expect(err).NotTo(HaveOccurred())
 				Expect(podList.Items).NotTo(BeEmpty())
 
@@ -170,12 +176,176 @@ var _ = Describe("PodManager", Label("pod_manager_test"), func() {
 				Expect(hasPod).To(BeTrue())
 			})
 
+			It("filters results by a label selector, including match expressions", func() {
+				ctx := context.TODO()
+				err := fakeClient.Create(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+
+				selector := &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "foo", Operator: metav1.LabelSelectorOpExists},
+					},
+				}
+				podList, err := podManager.ListPods(ctx, podmanager.WithLabelSelector(selector))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(podList.Items).NotTo(BeEmpty())
+
+				hasPod := false
+				for _, pod := range podList.Items {
+					if pod.Name == podName {
+						hasPod = true
+						break
+					}
+				}
+				Expect(hasPod).To(BeTrue())
+			})
+
+			It("rejects an invalid label selector", func() {
+				ctx := context.TODO()
+
+				invalidSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"": ""}}
+				podList, err := podManager.ListPods(ctx, podmanager.WithLabelSelector(invalidSelector))
+				Expect(err).To(HaveOccurred())
+				Expect(podList).To(BeNil())
+			})
+
 			It("filters results by field selector", func() {
 				ctx := context.TODO()
 				err := fakeClient.Create(ctx, podT)
 				Expect(err).NotTo(HaveOccurred())
 
-				podList, err := podManager.ListPods(ctx, client.MatchingFields{metav1.ObjectNameField: podName})
+				podList, err := podManager.ListPods(ctx, podmanager.WithFields(fields.OneTermEqualSelector(metav1.ObjectNameField, podName)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(podList.Items).NotTo(BeEmpty())
+
+				hasPod := false
+				for _, pod := range podList.Items {
+					if pod.Name == podName {
+						hasPod = true
+						break
+					}
+				}
+				Expect(hasPod).To(BeTrue())
+			})
+
+			It("filters results by node name", func() {
+				podT.Spec.NodeName = fmt.Sprintf("node-%v", count)
+
+				ctx := context.TODO()
+				err := fakeClient.Create(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+
+				podList, err := podManager.ListPods(ctx, podmanager.WithNodeName(podT.Spec.NodeName))
+				Expect(err).NotTo(HaveOccurred())
+
+				hasPod := false
+				for _, pod := range podList.Items {
+					if pod.Name == podName {
+						hasPod = true
+						break
+					}
+				}
+				Expect(hasPod).To(BeTrue())
+			})
+
+			It("filters results by phase", func() {
+				ctx := context.TODO()
+				err := fakeClient.Create(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+
+				podT.Status.Phase = corev1.PodRunning
+				Expect(fakeClient.Status().Update(ctx, podT)).NotTo(HaveOccurred())
+
+				podList, err := podManager.ListPods(ctx, podmanager.WithPhase(corev1.PodRunning))
+				Expect(err).NotTo(HaveOccurred())
+
+				hasPod := false
+				for _, pod := range podList.Items {
+					if pod.Name == podName {
+						hasPod = true
+						break
+					}
+				}
+				Expect(hasPod).To(BeTrue())
+			})
+
+			It("filters results by owner kind", func() {
+				podT.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(&appsv1.Deployment{
+						ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("deploy-%v", count), UID: types.UID("uid-deploy")},
+					}, appsv1.SchemeGroupVersion.WithKind("Deployment")),
+				}
+
+				ctx := context.TODO()
+				err := fakeClient.Create(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+
+				podList, err := podManager.ListPods(ctx, podmanager.WithOwnerKind(schema.GroupKind{Group: "apps", Kind: "Deployment"}))
+				Expect(err).NotTo(HaveOccurred())
+
+				hasPod := false
+				for _, pod := range podList.Items {
+					if pod.Name == podName {
+						hasPod = true
+						break
+					}
+				}
+				Expect(hasPod).To(BeTrue())
+
+				podList, err = podManager.ListPods(ctx, podmanager.WithOwnerKind(schema.GroupKind{Kind: "StatefulSet"}))
+				Expect(err).NotTo(HaveOccurred())
+				for _, pod := range podList.Items {
+					Expect(pod.Name).NotTo(Equal(podName))
+				}
+			})
+
+			It("filters results by top controller", func() {
+				deployment := &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("deploy-%v", count), Namespace: namespace},
+				}
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, deployment)).NotTo(HaveOccurred())
+
+				podT.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(deployment, appsv1.SchemeGroupVersion.WithKind("Deployment")),
+				}
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				podList, err := podManager.ListPods(ctx, podmanager.WithTopController(deployment))
+				Expect(err).NotTo(HaveOccurred())
+
+				hasPod := false
+				for _, pod := range podList.Items {
+					if pod.Name == podName {
+						hasPod = true
+						break
+					}
+				}
+				Expect(hasPod).To(BeTrue())
+
+				Expect(fakeClient.Delete(ctx, deployment)).NotTo(HaveOccurred())
+			})
+		})
+
+		Describe("MustParseSelector", func() {
+			It("parses a valid selector string", func() {
+				selector := podmanager.MustParseSelector("foo=bar,env!=prod")
+				Expect(selector.Matches(labels.Set{"foo": "bar"})).To(BeTrue())
+				Expect(selector.Matches(labels.Set{"foo": "bar", "env": "prod"})).To(BeFalse())
+			})
+
+			It("panics on an invalid selector string", func() {
+				Expect(func() { podmanager.MustParseSelector("???") }).To(Panic())
+			})
+		})
+
+		Describe("ListPodsRaw", func() {
+			It("falls back to raw controller-runtime list options", func() {
+				ctx := context.TODO()
+				err := fakeClient.Create(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+
+				podList, err := podManager.ListPodsRaw(ctx, client.InNamespace(namespace), client.MatchingLabels(labels))
 				Expect(err).NotTo(HaveOccurred())
 				Expect(podList.Items).NotTo(BeEmpty())
 
@@ -320,6 +490,323 @@ var _ = Describe("PodManager", Label("pod_manager_test"), func() {
 			})
 		})
 
-		PDescribe("GetPodTopController", func() {})
+		Describe("BeginOpsLifecycle and CompleteOpsLifecycle", func() {
+			It("begins a lifecycle on a Pod", func() {
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				err := podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-1", "Delete")
+				Expect(err).NotTo(HaveOccurred())
+
+				var pod corev1.Pod
+				Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, &pod)).NotTo(HaveOccurred())
+				Expect(pod.GetAnnotations()[constant.AnnotationPrepareDelete]).To(Equal("Delete"))
+				Expect(pod.GetAnnotations()[constant.AnnotationOpsID]).To(Equal("ops-1"))
+			})
+
+			It("is re-entrant for the same ops ID", func() {
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				Expect(podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-1", "Delete")).NotTo(HaveOccurred())
+				Expect(podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-1", "Delete")).NotTo(HaveOccurred())
+			})
+
+			It("does not regress a Pod already advanced to operating for the same ops ID", func() {
+				podT.Annotations = map[string]string{
+					constant.AnnotationOperating:    "Delete",
+					constant.AnnotationOpsID:        "ops-1",
+					constant.AnnotationOpsStartTime: time.Now().Format(time.RFC3339Nano),
+				}
+
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				Expect(podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-1", "Delete")).NotTo(HaveOccurred())
+
+				var pod corev1.Pod
+				Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, &pod)).NotTo(HaveOccurred())
+				Expect(pod.GetAnnotations()[constant.AnnotationOperating]).To(Equal("Delete"))
+				Expect(pod.GetAnnotations()).NotTo(HaveKey(constant.AnnotationPrepareDelete))
+			})
+
+			It("does not regress a Pod already completed for the same ops ID", func() {
+				podT.Annotations = map[string]string{
+					constant.AnnotationCompleted: "ops-1",
+					constant.AnnotationOpsID:     "ops-1",
+				}
+
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				Expect(podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-1", "Delete")).NotTo(HaveOccurred())
+
+				var pod corev1.Pod
+				Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, &pod)).NotTo(HaveOccurred())
+				Expect(pod.GetAnnotations()[constant.AnnotationCompleted]).To(Equal("ops-1"))
+				Expect(pod.GetAnnotations()).NotTo(HaveKey(constant.AnnotationPrepareDelete))
+			})
+
+			It("blocks a competing ops ID while the lifecycle is still fresh", func() {
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+				Expect(podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-1", "Delete")).NotTo(HaveOccurred())
+
+				err := podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-2", "Delete")
+				Expect(err).To(MatchError(constant.ErrLifecycleBlocked))
+			})
+
+			It("allows a competing ops ID to take over once the lifecycle has expired", func() {
+				podT.Annotations = map[string]string{
+					constant.AnnotationPrepareDelete: "Delete",
+					constant.AnnotationOpsID:         "ops-1",
+					constant.AnnotationOpsStartTime:  time.Now().Add(-24 * time.Hour).Format(time.RFC3339Nano),
+				}
+
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				err := podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-2", "Delete")
+				Expect(err).NotTo(HaveOccurred())
+
+				var pod corev1.Pod
+				Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, &pod)).NotTo(HaveOccurred())
+				Expect(pod.GetAnnotations()[constant.AnnotationOpsID]).To(Equal("ops-2"))
+			})
+
+			It("runs out of retries to begin a lifecycle, but conflicts still occur", func() {
+				patches := gomonkey.ApplyMethodReturn(fakeClient, "Update", apierrors.NewConflict(schema.GroupResource{Resource: "test"}, "other", nil))
+				defer patches.Reset()
+
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				err := podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-1", "Delete")
+				Expect(err).To(MatchError(constant.ErrRetriesExhausted))
+			})
+
+			It("completes a lifecycle matching the ops ID", func() {
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+				Expect(podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-1", "Delete")).NotTo(HaveOccurred())
+
+				Expect(podManager.CompleteOpsLifecycle(ctx, namespace, podName, "ops-1")).NotTo(HaveOccurred())
+
+				var pod corev1.Pod
+				Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, &pod)).NotTo(HaveOccurred())
+				Expect(pod.GetAnnotations()[constant.AnnotationCompleted]).To(Equal("ops-1"))
+				Expect(pod.GetAnnotations()).NotTo(HaveKey(constant.AnnotationOpsID))
+			})
+
+			It("refuses to complete a lifecycle owned by a different ops ID", func() {
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+				Expect(podManager.BeginOpsLifecycle(ctx, namespace, podName, "ops-1", "Delete")).NotTo(HaveOccurred())
+
+				err := podManager.CompleteOpsLifecycle(ctx, namespace, podName, "ops-2")
+				Expect(err).To(MatchError(constant.ErrLifecycleBlocked))
+			})
+
+			It("is a no-op completing a Pod that isn't in a lifecycle", func() {
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				Expect(podManager.CompleteOpsLifecycle(ctx, namespace, podName, "ops-1")).NotTo(HaveOccurred())
+			})
+		})
+
+		Describe("GetPodTopController", func() {
+			It("inputs nil pod", func() {
+				ctx := context.TODO()
+				top, err := podManager.GetPodTopController(ctx, nil)
+				Expect(err).To(MatchError(constant.ErrWrongInput))
+				Expect(top).To(Equal(podmanager.PodTopController{}))
+			})
+
+			It("resolves a bare Pod with no owner", func() {
+				ctx := context.TODO()
+				err := fakeClient.Create(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+
+				top, err := podManager.GetPodTopController(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(top.Kind).To(Equal(constant.KindPod))
+				Expect(top.Object).To(Equal(podT))
+			})
+
+			It("resolves a Pod with only non-controller owners as itself", func() {
+				podT.OwnerReferences = []metav1.OwnerReference{
+					{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "some-configmap",
+						UID:        types.UID("uid-configmap"),
+					},
+				}
+
+				ctx := context.TODO()
+				err := fakeClient.Create(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+
+				top, err := podManager.GetPodTopController(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(top.Kind).To(Equal(constant.KindPod))
+				Expect(top.Object.GetName()).To(Equal(podT.Name))
+			})
+
+			It("resolves Deployment -> ReplicaSet -> Pod", func() {
+				deployment := &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("deploy-%v", count), Namespace: namespace},
+				}
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, deployment)).NotTo(HaveOccurred())
+
+				replicaSet := &appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("rs-%v", count),
+						Namespace: namespace,
+						OwnerReferences: []metav1.OwnerReference{
+							*metav1.NewControllerRef(deployment, appsv1.SchemeGroupVersion.WithKind("Deployment")),
+						},
+					},
+				}
+				Expect(fakeClient.Create(ctx, replicaSet)).NotTo(HaveOccurred())
+
+				podT.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(replicaSet, appsv1.SchemeGroupVersion.WithKind("ReplicaSet")),
+				}
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				top, err := podManager.GetPodTopController(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(top.Kind).To(Equal(constant.KindDeployment))
+				Expect(top.Object.GetName()).To(Equal(deployment.Name))
+
+				Expect(fakeClient.Delete(ctx, deployment)).NotTo(HaveOccurred())
+				Expect(fakeClient.Delete(ctx, replicaSet)).NotTo(HaveOccurred())
+			})
+
+			It("resolves CronJob -> Job -> Pod", func() {
+				cronJob := &batchv1.CronJob{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("cronjob-%v", count), Namespace: namespace},
+				}
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, cronJob)).NotTo(HaveOccurred())
+
+				job := &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("job-%v", count),
+						Namespace: namespace,
+						OwnerReferences: []metav1.OwnerReference{
+							*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob")),
+						},
+					},
+				}
+				Expect(fakeClient.Create(ctx, job)).NotTo(HaveOccurred())
+
+				podT.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job")),
+				}
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				top, err := podManager.GetPodTopController(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(top.Kind).To(Equal(constant.KindCronJob))
+				Expect(top.Object.GetName()).To(Equal(cronJob.Name))
+
+				Expect(fakeClient.Delete(ctx, cronJob)).NotTo(HaveOccurred())
+				Expect(fakeClient.Delete(ctx, job)).NotTo(HaveOccurred())
+			})
+
+			It("resolves a standalone ReplicaSet as its own top controller", func() {
+				replicaSet := &appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("standalone-rs-%v", count), Namespace: namespace},
+				}
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, replicaSet)).NotTo(HaveOccurred())
+
+				podT.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(replicaSet, appsv1.SchemeGroupVersion.WithKind("ReplicaSet")),
+				}
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				top, err := podManager.GetPodTopController(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(top.Kind).To(Equal(constant.KindReplicaSet))
+				Expect(top.Object.GetName()).To(Equal(replicaSet.Name))
+
+				Expect(fakeClient.Delete(ctx, replicaSet)).NotTo(HaveOccurred())
+			})
+
+			It("resolves a StatefulSet-owned Pod directly", func() {
+				statefulSet := &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("sts-%v", count), Namespace: namespace},
+				}
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, statefulSet)).NotTo(HaveOccurred())
+
+				podT.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(statefulSet, appsv1.SchemeGroupVersion.WithKind("StatefulSet")),
+				}
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				top, err := podManager.GetPodTopController(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(top.Kind).To(Equal(constant.KindStatefulSet))
+				Expect(top.Object.GetName()).To(Equal(statefulSet.Name))
+
+				Expect(fakeClient.Delete(ctx, statefulSet)).NotTo(HaveOccurred())
+			})
+
+			It("resolves a DaemonSet-owned Pod directly", func() {
+				daemonSet := &appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ds-%v", count), Namespace: namespace},
+				}
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, daemonSet)).NotTo(HaveOccurred())
+
+				podT.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(daemonSet, appsv1.SchemeGroupVersion.WithKind("DaemonSet")),
+				}
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				top, err := podManager.GetPodTopController(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(top.Kind).To(Equal(constant.KindDaemonSet))
+				Expect(top.Object.GetName()).To(Equal(daemonSet.Name))
+
+				Expect(fakeClient.Delete(ctx, daemonSet)).NotTo(HaveOccurred())
+			})
+
+			It("returns an unknown CRD owner as the top controller", func() {
+				crd := &unstructured.Unstructured{}
+				crd.SetAPIVersion("spiderpool.spidernet.io/v2beta1")
+				crd.SetKind("SpiderSomeCRD")
+				crd.SetName(fmt.Sprintf("crd-%v", count))
+				crd.SetNamespace(namespace)
+				crd.SetUID(types.UID(fmt.Sprintf("crd-uid-%v", count)))
+
+				ctx := context.TODO()
+				Expect(fakeClient.Create(ctx, crd)).NotTo(HaveOccurred())
+
+				podT.OwnerReferences = []metav1.OwnerReference{
+					{
+						APIVersion: "spiderpool.spidernet.io/v2beta1",
+						Kind:       "SpiderSomeCRD",
+						Name:       crd.GetName(),
+						UID:        crd.GetUID(),
+						Controller: pointer.Bool(true),
+					},
+				}
+				Expect(fakeClient.Create(ctx, podT)).NotTo(HaveOccurred())
+
+				top, err := podManager.GetPodTopController(ctx, podT)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(top.Kind).To(Equal("SpiderSomeCRD"))
+				Expect(top.Object.GetName()).To(Equal(crd.GetName()))
+
+				Expect(fakeClient.Delete(ctx, crd)).NotTo(HaveOccurred())
+			})
+		})
 	})
 })
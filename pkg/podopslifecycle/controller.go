@@ -0,0 +1,69 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package podopslifecycle
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+	"github.com/spidernet-io/spiderpool/pkg/workloadendpointmanager"
+)
+
+// Reconciler releases a Pod's IPAM endpoint once PodMutator has moved it into
+// the operating phase of an ops lifecycle, then calls
+// PodManager.CompleteOpsLifecycle so the operation that called
+// BeginOpsLifecycle can proceed. OperatingPredicate keeps SetupWithManager
+// from queuing Pods outside that phase.
+type Reconciler struct {
+	PodManager              podmanager.PodManager
+	WorkloadEndpointManager workloadendpointmanager.WorkloadEndpointManager
+}
+
+// SetupWithManager registers the Reconciler with mgr, watching Pods and
+// filtering informer events down to the operating phase via
+// OperatingPredicate.
+func (r *Reconciler) SetupWithManager(mgr manager.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Pod{}, builder.WithPredicates(OperatingPredicate())).
+		Complete(r)
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	pod, err := r.PodManager.GetPodByName(ctx, req.Namespace, req.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !IsOperating(pod) {
+		// Stale event: the Pod moved on (or out of) the operating phase
+		// before this reconcile ran.
+		return reconcile.Result{}, nil
+	}
+
+	opsID := pod.Annotations[constant.AnnotationOpsID]
+	if opsID == "" {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.WorkloadEndpointManager.ReleaseEndpoint(ctx, pod.Namespace, pod.Name); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.PodManager.CompleteOpsLifecycle(ctx, pod.Namespace, pod.Name, opsID); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
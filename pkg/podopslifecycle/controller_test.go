@@ -0,0 +1,102 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package podopslifecycle_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+	"github.com/spidernet-io/spiderpool/pkg/podopslifecycle"
+	"github.com/spidernet-io/spiderpool/pkg/workloadendpointmanager"
+)
+
+func reconcilerTestScheme() *runtime.Scheme {
+	scheme := clientgoscheme.Scheme.DeepCopy()
+	if err := spiderpoolv2beta1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+	return scheme
+}
+
+var _ = Describe("Reconciler", Label("controller_test"), func() {
+	var k8sClient client.Client
+	var reconciler *podopslifecycle.Reconciler
+	var namespace, podName string
+
+	BeforeEach(func() {
+		namespace = "default"
+		podName = "pod"
+
+		k8sClient = fake.NewClientBuilder().WithScheme(reconcilerTestScheme()).Build()
+
+		podManager, err := podmanager.NewPodManager(podmanager.PodManagerConfig{}, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		endpointManager, err := workloadendpointmanager.NewWorkloadEndpointManager(workloadendpointmanager.WorkloadEndpointManagerConfig{}, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		reconciler = &podopslifecycle.Reconciler{PodManager: podManager, WorkloadEndpointManager: endpointManager}
+	})
+
+	req := func() reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: podName}}
+	}
+
+	It("releases the endpoint and completes the lifecycle for an operating Pod", func() {
+		ctx := context.TODO()
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      podName,
+				Namespace: namespace,
+				Annotations: map[string]string{
+					constant.AnnotationOperating: "Delete",
+					constant.AnnotationOpsID:     "ops-1",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pod)).NotTo(HaveOccurred())
+
+		endpoint := &spiderpoolv2beta1.SpiderEndpoint{ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: namespace}}
+		Expect(k8sClient.Create(ctx, endpoint)).NotTo(HaveOccurred())
+
+		_, err := reconciler.Reconcile(ctx, req())
+		Expect(err).NotTo(HaveOccurred())
+
+		var gotEndpoint spiderpoolv2beta1.SpiderEndpoint
+		err = k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, &gotEndpoint)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		var gotPod corev1.Pod
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, &gotPod)).NotTo(HaveOccurred())
+		Expect(gotPod.Annotations[constant.AnnotationCompleted]).To(Equal("ops-1"))
+	})
+
+	It("is a no-op for a Pod that is not in the operating phase", func() {
+		ctx := context.TODO()
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: namespace}}
+		Expect(k8sClient.Create(ctx, pod)).NotTo(HaveOccurred())
+
+		_, err := reconciler.Reconcile(ctx, req())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("is a no-op for a Pod that no longer exists", func() {
+		_, err := reconciler.Reconcile(context.TODO(), req())
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
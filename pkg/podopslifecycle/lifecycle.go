@@ -0,0 +1,69 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+// Package podopslifecycle implements the lifecycle-annotation handoff
+// protocol (modeled on KusionStack Operating's PodOpsLifecycle) that lets a
+// controller request to delete or update a Pod while giving Spiderpool a
+// chance to preserve or transfer its IPAM state first. A Pod moves through
+// prepare-delete -> operating -> completed: podmanager.PodManager.BeginOpsLifecycle
+// stamps prepare-delete, this package's PodMutator (via Enter) advances it to
+// operating, and podmanager.PodManager.CompleteOpsLifecycle marks it
+// completed. This package also reads that state to gate admission and
+// informer events.
+package podopslifecycle
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+)
+
+// Phase is the stage a Pod is in within an in-flight ops lifecycle.
+type Phase string
+
+const (
+	PhasePrepareDelete Phase = "prepare-delete"
+	PhaseOperating     Phase = "operating"
+	PhaseCompleted     Phase = "completed"
+)
+
+// GetPhase returns the current lifecycle phase stamped on pod, and false if
+// the Pod is not part of any ops lifecycle.
+func GetPhase(pod *corev1.Pod) (Phase, bool) {
+	annotations := pod.GetAnnotations()
+
+	switch {
+	case annotations[constant.AnnotationCompleted] != "":
+		return PhaseCompleted, true
+	case annotations[constant.AnnotationOperating] != "":
+		return PhaseOperating, true
+	case annotations[constant.AnnotationPrepareDelete] != "":
+		return PhasePrepareDelete, true
+	default:
+		return "", false
+	}
+}
+
+// IsOperating reports whether pod has entered the operating phase of an ops
+// lifecycle, meaning it is safe for IPAM reconciliation to act on it.
+func IsOperating(pod *corev1.Pod) bool {
+	phase, ok := GetPhase(pod)
+	return ok && phase == PhaseOperating
+}
+
+// Enter transitions pod from prepare-delete into the operating phase,
+// carrying over the recorded ops value. It is a no-op if the Pod has not
+// (yet) entered prepare-delete.
+func Enter(pod *corev1.Pod) *corev1.Pod {
+	phase, ok := GetPhase(pod)
+	if !ok || phase != PhasePrepareDelete {
+		return pod
+	}
+
+	annotations := pod.GetAnnotations()
+	annotations[constant.AnnotationOperating] = annotations[constant.AnnotationPrepareDelete]
+	delete(annotations, constant.AnnotationPrepareDelete)
+	pod.SetAnnotations(annotations)
+
+	return pod
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package podopslifecycle_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	"github.com/spidernet-io/spiderpool/pkg/podopslifecycle"
+)
+
+var _ = Describe("PodOpsLifecycle", Label("lifecycle_test"), func() {
+	Describe("GetPhase", func() {
+		It("reports no phase for a Pod outside any lifecycle", func() {
+			pod := &corev1.Pod{}
+
+			_, ok := podopslifecycle.GetPhase(pod)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("reports prepare-delete", func() {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constant.AnnotationPrepareDelete: "Delete"}}}
+
+			phase, ok := podopslifecycle.GetPhase(pod)
+			Expect(ok).To(BeTrue())
+			Expect(phase).To(Equal(podopslifecycle.PhasePrepareDelete))
+		})
+
+		It("reports operating", func() {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constant.AnnotationOperating: "Delete"}}}
+
+			phase, ok := podopslifecycle.GetPhase(pod)
+			Expect(ok).To(BeTrue())
+			Expect(phase).To(Equal(podopslifecycle.PhaseOperating))
+		})
+
+		It("reports completed", func() {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constant.AnnotationCompleted: "Delete"}}}
+
+			phase, ok := podopslifecycle.GetPhase(pod)
+			Expect(ok).To(BeTrue())
+			Expect(phase).To(Equal(podopslifecycle.PhaseCompleted))
+		})
+
+		It("prefers completed over a stale operating annotation", func() {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				constant.AnnotationOperating: "Delete",
+				constant.AnnotationCompleted: "Delete",
+			}}}
+
+			phase, ok := podopslifecycle.GetPhase(pod)
+			Expect(ok).To(BeTrue())
+			Expect(phase).To(Equal(podopslifecycle.PhaseCompleted))
+		})
+	})
+
+	Describe("IsOperating", func() {
+		It("returns true for a Pod in the operating phase", func() {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constant.AnnotationOperating: "Delete"}}}
+			Expect(podopslifecycle.IsOperating(pod)).To(BeTrue())
+		})
+
+		It("returns false for a Pod still in prepare-delete", func() {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constant.AnnotationPrepareDelete: "Delete"}}}
+			Expect(podopslifecycle.IsOperating(pod)).To(BeFalse())
+		})
+
+		It("returns false for a Pod outside any lifecycle", func() {
+			Expect(podopslifecycle.IsOperating(&corev1.Pod{})).To(BeFalse())
+		})
+	})
+
+	Describe("Enter", func() {
+		It("transitions a Pod from prepare-delete to operating, carrying over the ops value", func() {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constant.AnnotationPrepareDelete: "Delete"}}}
+
+			mutated := podopslifecycle.Enter(pod)
+			Expect(mutated.GetAnnotations()).NotTo(HaveKey(constant.AnnotationPrepareDelete))
+			Expect(mutated.GetAnnotations()[constant.AnnotationOperating]).To(Equal("Delete"))
+		})
+
+		It("is a no-op once already operating", func() {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constant.AnnotationOperating: "Delete"}}}
+
+			again := podopslifecycle.Enter(pod)
+			Expect(again.GetAnnotations()[constant.AnnotationOperating]).To(Equal("Delete"))
+		})
+
+		It("is a no-op for a Pod outside any lifecycle", func() {
+			pod := &corev1.Pod{}
+			Expect(podopslifecycle.Enter(pod)).To(BeIdenticalTo(pod))
+		})
+	})
+})
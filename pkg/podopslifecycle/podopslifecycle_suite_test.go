@@ -0,0 +1,16 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package podopslifecycle_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPodOpsLifecycle(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PodOpsLifecycle Suite")
+}
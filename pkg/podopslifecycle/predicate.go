@@ -0,0 +1,24 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package podopslifecycle
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// OperatingPredicate filters informer events down to Pods that have entered
+// the operating phase of an ops lifecycle, so IPAM reconciliation only spends
+// work on Pods it is actually allowed to act on.
+func OperatingPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(object client.Object) bool {
+		pod, ok := object.(*corev1.Pod)
+		if !ok {
+			return false
+		}
+
+		return IsOperating(pod)
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package podopslifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager registers PodMutator and PodValidator on mgr's
+// webhook server, so Pod update/delete requests actually get routed through
+// the ops lifecycle handoff protocol this package implements rather than
+// being gated by dead code.
+func SetupWebhookWithManager(mgr manager.Manager) error {
+	decoder := admission.NewDecoder(mgr.GetScheme())
+
+	server := mgr.GetWebhookServer()
+	server.Register("/mutate-v1-pod-ops-lifecycle", &webhook.Admission{Handler: NewPodMutator(decoder)})
+	server.Register("/validate-v1-pod-ops-lifecycle", &webhook.Admission{Handler: NewPodValidator(decoder)})
+
+	return nil
+}
+
+// PodMutator advances a Pod's ops lifecycle from prepare-delete to operating
+// as the delete/update request it was prepared for reaches admission, so
+// OperatingPredicate-gated consumers only ever see it once the handoff has
+// actually begun.
+type PodMutator struct {
+	decoder admission.Decoder
+}
+
+func NewPodMutator(decoder admission.Decoder) *PodMutator {
+	return &PodMutator{decoder: decoder}
+}
+
+func (m *PodMutator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	pod := &corev1.Pod{}
+	if err := m.decoder.Decode(req, pod); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	mutated := Enter(pod.DeepCopy())
+
+	marshaled, err := json.Marshal(mutated)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// PodValidator blocks a Pod's deletion while it is still waiting in
+// prepare-delete for IPAM to hand off its IP, so the actual removal cannot
+// race ahead of podmanager.PodManager.BeginOpsLifecycle's caller.
+type PodValidator struct {
+	decoder admission.Decoder
+}
+
+func NewPodValidator(decoder admission.Decoder) *PodValidator {
+	return &PodValidator{decoder: decoder}
+}
+
+func (v *PodValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Delete {
+		return admission.Allowed("")
+	}
+
+	pod := &corev1.Pod{}
+	if err := v.decoder.DecodeRaw(req.OldObject, pod); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if phase, ok := GetPhase(pod); ok && phase == PhasePrepareDelete {
+		return admission.Denied("pod is awaiting IPAM handoff before it can be deleted")
+	}
+
+	return admission.Allowed("")
+}
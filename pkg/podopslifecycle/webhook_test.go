@@ -0,0 +1,123 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package podopslifecycle_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	"github.com/spidernet-io/spiderpool/pkg/podopslifecycle"
+)
+
+func rawPod(pod *corev1.Pod) runtime.RawExtension {
+	raw, err := json.Marshal(pod)
+	Expect(err).NotTo(HaveOccurred())
+	return runtime.RawExtension{Raw: raw}
+}
+
+func newDecoder() admission.Decoder {
+	scheme := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	return admission.NewDecoder(scheme)
+}
+
+var _ = Describe("PodMutator", Label("webhook_test"), func() {
+	var mutator *podopslifecycle.PodMutator
+
+	BeforeEach(func() {
+		mutator = podopslifecycle.NewPodMutator(newDecoder())
+	})
+
+	It("advances a prepare-delete Pod into operating", func() {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod",
+				Namespace: "default",
+				Annotations: map[string]string{
+					constant.AnnotationPrepareDelete: "Delete",
+					constant.AnnotationOpsID:         "ops-1",
+				},
+			},
+		}
+
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Object: rawPod(pod),
+		}}
+
+		resp := mutator.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).NotTo(BeEmpty())
+	})
+
+	It("rejects a request it cannot decode", func() {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Object: runtime.RawExtension{Raw: []byte("not-json")},
+		}}
+
+		resp := mutator.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Code).To(Equal(int32(http.StatusBadRequest)))
+	})
+})
+
+var _ = Describe("PodValidator", Label("webhook_test"), func() {
+	var validator *podopslifecycle.PodValidator
+
+	BeforeEach(func() {
+		validator = podopslifecycle.NewPodValidator(newDecoder())
+	})
+
+	It("allows non-delete operations without decoding the old object", func() {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Update}}
+
+		resp := validator.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("blocks deleting a Pod still waiting in prepare-delete", func() {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pod",
+				Namespace:   "default",
+				Annotations: map[string]string{constant.AnnotationPrepareDelete: "Delete"},
+			},
+		}
+
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Delete,
+			OldObject: rawPod(pod),
+		}}
+
+		resp := validator.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeFalse())
+	})
+
+	It("allows deleting a Pod outside prepare-delete", func() {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod",
+				Namespace: "default",
+			},
+		}
+
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Delete,
+			OldObject: rawPod(pod),
+		}}
+
+		resp := validator.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})
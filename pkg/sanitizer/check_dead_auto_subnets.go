@@ -0,0 +1,128 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+	"github.com/spidernet-io/spiderpool/pkg/subnetmanager"
+)
+
+// podSubnetAnnotation mirrors the JSON value of a Pod's
+// ipam.spidernet.io/subnet(s) annotation, which requests an auto-pool from a
+// SpiderSubnet.
+type podSubnetAnnotation struct {
+	NIC      string `json:"interface,omitempty"`
+	Subnet   string `json:"ipv4,omitempty"`
+	SubnetV6 string `json:"ipv6,omitempty"`
+}
+
+// DeadAutoSubnetCheck flags top controllers (see
+// podmanager.PodManager.GetPodTopController) whose Pods request auto-pool
+// creation, via their ipam.spidernet.io/subnet(s) annotation, from a
+// SpiderSubnet that no longer exists. Each top controller is reported once.
+type DeadAutoSubnetCheck struct {
+	PodManager    podmanager.PodManager
+	SubnetManager subnetmanager.SubnetManager
+}
+
+func (c *DeadAutoSubnetCheck) Name() string { return "DeadAutoSubnet" }
+
+func (c *DeadAutoSubnetCheck) Run(ctx context.Context) ([]Finding, error) {
+	podList, err := c.PodManager.ListPods(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetList, err := c.SubnetManager.ListSubnets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existingSubnets := make(map[string]struct{}, len(subnetList.Items))
+	for _, subnet := range subnetList.Items {
+		existingSubnets[subnet.Name] = struct{}{}
+	}
+
+	// A given top controller fans out to many Pods; only report it once.
+	reported := make(map[string]struct{})
+
+	var findings []Finding
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+
+		subnets := requestedSubnets(pod.Annotations)
+		if len(subnets) == 0 {
+			continue
+		}
+
+		missing := ""
+		for _, subnet := range subnets {
+			if _, ok := existingSubnets[subnet]; !ok {
+				missing = subnet
+				break
+			}
+		}
+		if missing == "" {
+			continue
+		}
+
+		top, err := c.PodManager.GetPodTopController(ctx, pod)
+		if err != nil {
+			return nil, err
+		}
+
+		key := fmt.Sprintf("%s/%s/%s", top.Kind, top.Object.GetNamespace(), top.Object.GetName())
+		if _, already := reported[key]; already {
+			continue
+		}
+		reported[key] = struct{}{}
+
+		findings = append(findings, Finding{
+			Check:     c.Name(),
+			Severity:  SeverityError,
+			Namespace: top.Object.GetNamespace(),
+			Name:      top.Object.GetName(),
+			Message:   fmt.Sprintf("%s references nonexistent auto-pool SpiderSubnet %q", top.Kind, missing),
+		})
+	}
+
+	return findings, nil
+}
+
+func requestedSubnets(annotations map[string]string) []string {
+	var subnets []string
+
+	if raw, ok := annotations[constant.AnnotationSubnet]; ok {
+		var anno podSubnetAnnotation
+		if err := json.Unmarshal([]byte(raw), &anno); err == nil {
+			subnets = append(subnets, nonEmpty(anno.Subnet, anno.SubnetV6)...)
+		}
+	}
+
+	if raw, ok := annotations[constant.AnnotationSubnets]; ok {
+		var annos []podSubnetAnnotation
+		if err := json.Unmarshal([]byte(raw), &annos); err == nil {
+			for _, anno := range annos {
+				subnets = append(subnets, nonEmpty(anno.Subnet, anno.SubnetV6)...)
+			}
+		}
+	}
+
+	return subnets
+}
+
+func nonEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
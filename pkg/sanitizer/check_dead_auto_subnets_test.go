@@ -0,0 +1,30 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+)
+
+var _ = Describe("requestedSubnets", Label("check_dead_auto_subnets_test"), func() {
+	It("parses single- and multi-NIC annotations", func() {
+		annotations := map[string]string{
+			constant.AnnotationSubnet: `{"interface":"eth0","ipv4":"subnet1"}`,
+		}
+		Expect(requestedSubnets(annotations)).To(Equal([]string{"subnet1"}))
+
+		annotations = map[string]string{
+			constant.AnnotationSubnets: `[{"interface":"eth0","ipv4":"subnet1"},{"interface":"net1","ipv6":"subnet2"}]`,
+		}
+		Expect(requestedSubnets(annotations)).To(HaveLen(2))
+	})
+
+	It("ignores malformed annotations", func() {
+		annotations := map[string]string{constant.AnnotationSubnet: "not-json"}
+		Expect(requestedSubnets(annotations)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,105 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/spidernet-io/spiderpool/pkg/ippoolmanager"
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+)
+
+// EmptyPoolSelectorCheck flags SpiderIPPools whose PodAffinity or
+// NamespaceAffinity selector currently matches zero Pods, a sign the pool is
+// dead weight left over from a workload that moved on or was deleted.
+type EmptyPoolSelectorCheck struct {
+	PodManager    podmanager.PodManager
+	IPPoolManager ippoolmanager.IPPoolManager
+	// Client resolves NamespaceAffinity, which selects over Namespace
+	// labels rather than anything PodManager/IPPoolManager expose.
+	Client client.Client
+}
+
+func (c *EmptyPoolSelectorCheck) Name() string { return "EmptyPoolSelector" }
+
+func (c *EmptyPoolSelectorCheck) Run(ctx context.Context) ([]Finding, error) {
+	poolList, err := c.IPPoolManager.ListIPPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	for _, pool := range poolList.Items {
+		matches, err := c.matchesAnyPod(ctx, pool)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			continue
+		}
+
+		findings = append(findings, Finding{
+			Check:     c.Name(),
+			Severity:  SeverityWarning,
+			Namespace: pool.Spec.NamespaceName,
+			Name:      pool.Name,
+			Message:   "PodAffinity/NamespaceAffinity selector matches no pods",
+		})
+	}
+
+	return findings, nil
+}
+
+func (c *EmptyPoolSelectorCheck) matchesAnyPod(ctx context.Context, pool spiderpoolv2beta1.SpiderIPPool) (bool, error) {
+	if pool.Spec.PodAffinity == nil && pool.Spec.NamespaceAffinity == nil {
+		// No affinity configured at all: the pool is cluster-wide and
+		// always considered in use.
+		return true, nil
+	}
+
+	var podOpts []podmanager.ListOption
+	if pool.Spec.PodAffinity != nil {
+		podOpts = append(podOpts, podmanager.WithLabelSelector(pool.Spec.PodAffinity))
+	}
+
+	if pool.Spec.NamespaceAffinity == nil {
+		podList, err := c.PodManager.ListPods(ctx, podOpts...)
+		if err != nil {
+			return false, err
+		}
+		return len(podList.Items) > 0, nil
+	}
+
+	nsSelector, err := metav1.LabelSelectorAsSelector(pool.Spec.NamespaceAffinity)
+	if err != nil {
+		return false, err
+	}
+
+	var namespaces corev1.NamespaceList
+	if err := c.Client.List(ctx, &namespaces, client.MatchingLabelsSelector{Selector: nsSelector}); err != nil {
+		return false, err
+	}
+
+	// A Pod only counts if it lives in one of the matched namespaces, so
+	// scope each list to one namespace at a time rather than listing
+	// cluster-wide and assuming any match anywhere is good enough.
+	for _, ns := range namespaces.Items {
+		opts := append([]podmanager.ListOption{podmanager.WithNamespace(ns.Name)}, podOpts...)
+
+		podList, err := c.PodManager.ListPods(ctx, opts...)
+		if err != nil {
+			return false, err
+		}
+		if len(podList.Items) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
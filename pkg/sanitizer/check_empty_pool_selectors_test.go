@@ -0,0 +1,169 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/spidernet-io/spiderpool/pkg/ippoolmanager"
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+)
+
+var _ = Describe("EmptyPoolSelectorCheck", Label("check_empty_pool_selectors_test"), func() {
+	var count uint64
+	var k8sClient client.Client
+	var podManager podmanager.PodManager
+	var poolManager ippoolmanager.IPPoolManager
+	var check *EmptyPoolSelectorCheck
+
+	BeforeEach(func() {
+		atomic.AddUint64(&count, 1)
+
+		scheme := runtimeScheme()
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		pm, err := podmanager.NewPodManager(podmanager.PodManagerConfig{}, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+		podManager = pm
+
+		im, err := ippoolmanager.NewIPPoolManager(ippoolmanager.IPPoolManagerConfig{}, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+		poolManager = im
+
+		check = &EmptyPoolSelectorCheck{PodManager: podManager, IPPoolManager: poolManager, Client: k8sClient}
+	})
+
+	It("does not flag a pool with no affinity configured", func() {
+		pool := &spiderpoolv2beta1.SpiderIPPool{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pool-%v", count)}}
+		Expect(k8sClient.Create(context.TODO(), pool)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("flags a pool whose PodAffinity matches no pods", func() {
+		pool := &spiderpoolv2beta1.SpiderIPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pool-%v", count)},
+			Spec: spiderpoolv2beta1.SpiderIPPoolSpec{
+				PodAffinity: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nonexistent"}},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), pool)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+		Expect(findings[0].Name).To(Equal(pool.Name))
+	})
+
+	It("does not flag a pool whose PodAffinity matches a pod", func() {
+		namespace := "default"
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("pod-%v", count),
+				Namespace: namespace,
+				Labels:    map[string]string{"app": "web"},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), pod)).NotTo(HaveOccurred())
+
+		pool := &spiderpoolv2beta1.SpiderIPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pool-%v", count)},
+			Spec: spiderpoolv2beta1.SpiderIPPoolSpec{
+				PodAffinity: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), pool)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("does not count a matching pod outside the matched namespaces", func() {
+		matchedNS := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("matched-%v", count),
+				Labels: map[string]string{"env": "prod"},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), matchedNS)).NotTo(HaveOccurred())
+
+		otherNS := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("other-%v", count),
+				Labels: map[string]string{"env": "dev"},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), otherNS)).NotTo(HaveOccurred())
+
+		// The only matching Pod lives in otherNS, which NamespaceAffinity
+		// does not select -- this must still be reported as empty.
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("pod-%v", count),
+				Namespace: otherNS.Name,
+				Labels:    map[string]string{"app": "web"},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), pod)).NotTo(HaveOccurred())
+
+		pool := &spiderpoolv2beta1.SpiderIPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pool-%v", count)},
+			Spec: spiderpoolv2beta1.SpiderIPPoolSpec{
+				PodAffinity:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				NamespaceAffinity: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), pool)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+		Expect(findings[0].Name).To(Equal(pool.Name))
+	})
+
+	It("counts a matching pod inside a matched namespace", func() {
+		matchedNS := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("matched-%v", count),
+				Labels: map[string]string{"env": "prod"},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), matchedNS)).NotTo(HaveOccurred())
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("pod-%v", count),
+				Namespace: matchedNS.Name,
+				Labels:    map[string]string{"app": "web"},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), pod)).NotTo(HaveOccurred())
+
+		pool := &spiderpoolv2beta1.SpiderIPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pool-%v", count)},
+			Spec: spiderpoolv2beta1.SpiderIPPoolSpec{
+				PodAffinity:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				NamespaceAffinity: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), pool)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+})
@@ -0,0 +1,50 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+	"github.com/spidernet-io/spiderpool/pkg/workloadendpointmanager"
+)
+
+// OrphanedEndpointCheck flags SpiderEndpoints left behind after the Pod they
+// describe was deleted, which otherwise keep an IP reserved forever.
+type OrphanedEndpointCheck struct {
+	PodManager              podmanager.PodManager
+	WorkloadEndpointManager workloadendpointmanager.WorkloadEndpointManager
+}
+
+func (c *OrphanedEndpointCheck) Name() string { return "OrphanedEndpoint" }
+
+func (c *OrphanedEndpointCheck) Run(ctx context.Context) ([]Finding, error) {
+	endpointList, err := c.WorkloadEndpointManager.ListEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	for _, endpoint := range endpointList.Items {
+		_, err := c.PodManager.GetPodByName(ctx, endpoint.Namespace, endpoint.Name)
+		if err == nil {
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		findings = append(findings, Finding{
+			Check:     c.Name(),
+			Severity:  SeverityWarning,
+			Namespace: endpoint.Namespace,
+			Name:      endpoint.Name,
+			Message:   "owning pod no longer exists, its IP allocation may be leaked",
+		})
+	}
+
+	return findings, nil
+}
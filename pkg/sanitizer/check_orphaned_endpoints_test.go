@@ -0,0 +1,69 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+	"github.com/spidernet-io/spiderpool/pkg/workloadendpointmanager"
+)
+
+var _ = Describe("OrphanedEndpointCheck", Label("check_orphaned_endpoints_test"), func() {
+	var count uint64
+	var k8sClient client.Client
+	var check *OrphanedEndpointCheck
+
+	BeforeEach(func() {
+		atomic.AddUint64(&count, 1)
+
+		k8sClient = fake.NewClientBuilder().WithScheme(runtimeScheme()).Build()
+
+		podManager, err := podmanager.NewPodManager(podmanager.PodManagerConfig{}, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		endpointManager, err := workloadendpointmanager.NewWorkloadEndpointManager(workloadendpointmanager.WorkloadEndpointManagerConfig{}, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		check = &OrphanedEndpointCheck{PodManager: podManager, WorkloadEndpointManager: endpointManager}
+	})
+
+	It("flags a SpiderEndpoint whose Pod no longer exists", func() {
+		endpoint := &spiderpoolv2beta1.SpiderEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod-%v", count), Namespace: "default"},
+		}
+		Expect(k8sClient.Create(context.TODO(), endpoint)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+		Expect(findings[0].Name).To(Equal(endpoint.Name))
+		Expect(findings[0].Namespace).To(Equal(endpoint.Namespace))
+	})
+
+	It("does not flag a SpiderEndpoint whose Pod still exists", func() {
+		podName := fmt.Sprintf("pod-%v", count)
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: "default"}}
+		Expect(k8sClient.Create(context.TODO(), pod)).NotTo(HaveOccurred())
+
+		endpoint := &spiderpoolv2beta1.SpiderEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: "default"},
+		}
+		Expect(k8sClient.Create(context.TODO(), endpoint)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+})
@@ -0,0 +1,91 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	"github.com/spidernet-io/spiderpool/pkg/ippoolmanager"
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+)
+
+// podIPPoolAnnotation mirrors the JSON value Spiderpool's CNI plugin reads
+// off a Pod's ipam.spidernet.io/ippool(s) annotation to pin its IP pools.
+type podIPPoolAnnotation struct {
+	NIC       string   `json:"interface,omitempty"`
+	IPv4Pools []string `json:"ipv4,omitempty"`
+	IPv6Pools []string `json:"ipv6,omitempty"`
+}
+
+// PodIPPoolAnnotationCheck flags Pods whose ipam.spidernet.io/ippool(s)
+// annotation names a SpiderIPPool that no longer exists.
+type PodIPPoolAnnotationCheck struct {
+	PodManager    podmanager.PodManager
+	IPPoolManager ippoolmanager.IPPoolManager
+}
+
+func (c *PodIPPoolAnnotationCheck) Name() string { return "PodIPPoolAnnotation" }
+
+func (c *PodIPPoolAnnotationCheck) Run(ctx context.Context) ([]Finding, error) {
+	podList, err := c.PodManager.ListPods(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	poolList, err := c.IPPoolManager.ListIPPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existingPools := make(map[string]struct{}, len(poolList.Items))
+	for _, pool := range poolList.Items {
+		existingPools[pool.Name] = struct{}{}
+	}
+
+	var findings []Finding
+	for _, pod := range podList.Items {
+		for _, pool := range requestedPools(pod.Annotations) {
+			if _, ok := existingPools[pool]; ok {
+				continue
+			}
+
+			findings = append(findings, Finding{
+				Check:     c.Name(),
+				Severity:  SeverityError,
+				Namespace: pod.Namespace,
+				Name:      pod.Name,
+				Message:   fmt.Sprintf("references nonexistent SpiderIPPool %q", pool),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+func requestedPools(annotations map[string]string) []string {
+	var pools []string
+
+	if raw, ok := annotations[constant.AnnotationPodIPPool]; ok {
+		var anno podIPPoolAnnotation
+		if err := json.Unmarshal([]byte(raw), &anno); err == nil {
+			pools = append(pools, anno.IPv4Pools...)
+			pools = append(pools, anno.IPv6Pools...)
+		}
+	}
+
+	if raw, ok := annotations[constant.AnnotationPodIPPools]; ok {
+		var annos []podIPPoolAnnotation
+		if err := json.Unmarshal([]byte(raw), &annos); err == nil {
+			for _, anno := range annos {
+				pools = append(pools, anno.IPv4Pools...)
+				pools = append(pools, anno.IPv6Pools...)
+			}
+		}
+	}
+
+	return pools
+}
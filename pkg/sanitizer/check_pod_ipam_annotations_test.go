@@ -0,0 +1,34 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+)
+
+var _ = Describe("requestedPools", Label("check_pod_ipam_annotations_test"), func() {
+	It("parses single- and multi-NIC annotations", func() {
+		annotations := map[string]string{
+			constant.AnnotationPodIPPool: `{"interface":"eth0","ipv4":["pool1"]}`,
+		}
+		Expect(requestedPools(annotations)).To(Equal([]string{"pool1"}))
+
+		annotations = map[string]string{
+			constant.AnnotationPodIPPools: `[{"interface":"eth0","ipv4":["pool1"]},{"interface":"net1","ipv6":["pool2"]}]`,
+		}
+		Expect(requestedPools(annotations)).To(HaveLen(2))
+	})
+
+	It("ignores malformed annotations", func() {
+		annotations := map[string]string{constant.AnnotationPodIPPool: "not-json"}
+		Expect(requestedPools(annotations)).To(BeEmpty())
+	})
+
+	It("returns nothing for no annotations", func() {
+		Expect(requestedPools(nil)).To(BeEmpty())
+	})
+})
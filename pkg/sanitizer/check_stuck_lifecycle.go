@@ -0,0 +1,73 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+	"time"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+	"github.com/spidernet-io/spiderpool/pkg/podopslifecycle"
+)
+
+const defaultStuckLifecycleThreshold = 10 * time.Minute
+
+// StuckLifecycleCheck flags Pods that have sat in the operating phase of an
+// ops lifecycle for longer than Threshold, meaning whatever was meant to
+// hand off its IP and finish the operation never completed.
+type StuckLifecycleCheck struct {
+	PodManager podmanager.PodManager
+	// Threshold defaults to defaultStuckLifecycleThreshold when zero.
+	Threshold time.Duration
+}
+
+func (c *StuckLifecycleCheck) Name() string { return "StuckLifecycle" }
+
+func (c *StuckLifecycleCheck) Run(ctx context.Context) ([]Finding, error) {
+	podList, err := c.PodManager.ListPods(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := c.Threshold
+	if threshold == 0 {
+		threshold = defaultStuckLifecycleThreshold
+	}
+
+	var findings []Finding
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+
+		phase, ok := podopslifecycle.GetPhase(pod)
+		if !ok || phase != podopslifecycle.PhaseOperating {
+			continue
+		}
+
+		startedAt, err := time.Parse(time.RFC3339Nano, pod.Annotations[constant.AnnotationOpsStartTime])
+		if err != nil {
+			findings = append(findings, Finding{
+				Check:     c.Name(),
+				Severity:  SeverityWarning,
+				Namespace: pod.Namespace,
+				Name:      pod.Name,
+				Message:   "in the operating phase of an ops lifecycle with a missing or unparseable ops-start-time annotation",
+			})
+			continue
+		}
+		if time.Since(startedAt) < threshold {
+			continue
+		}
+
+		findings = append(findings, Finding{
+			Check:     c.Name(),
+			Severity:  SeverityError,
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			Message:   "stuck in the operating phase of an ops lifecycle",
+		})
+	}
+
+	return findings, nil
+}
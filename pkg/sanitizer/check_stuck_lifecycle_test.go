@@ -0,0 +1,122 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	"github.com/spidernet-io/spiderpool/pkg/podmanager"
+)
+
+var _ = Describe("StuckLifecycleCheck", Label("check_stuck_lifecycle_test"), func() {
+	var k8sClient client.Client
+	var podManager podmanager.PodManager
+
+	BeforeEach(func() {
+		k8sClient = fake.NewClientBuilder().Build()
+
+		manager, err := podmanager.NewPodManager(podmanager.PodManagerConfig{}, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+		podManager = manager
+	})
+
+	newPod := func(name string, annotations map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	It("flags a Pod stuck in operating past the threshold", func() {
+		check := &StuckLifecycleCheck{PodManager: podManager, Threshold: time.Minute}
+
+		ctx := context.TODO()
+		pod := newPod("stuck", map[string]string{
+			constant.AnnotationOperating:    "Delete",
+			constant.AnnotationOpsID:        "ops-1",
+			constant.AnnotationOpsStartTime: time.Now().Add(-time.Hour).Format(time.RFC3339Nano),
+		})
+		Expect(k8sClient.Create(ctx, pod)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+		Expect(findings[0].Name).To(Equal("stuck"))
+		Expect(findings[0].Severity).To(Equal(SeverityError))
+	})
+
+	It("ignores a Pod still within the threshold", func() {
+		check := &StuckLifecycleCheck{PodManager: podManager, Threshold: time.Hour}
+
+		ctx := context.TODO()
+		pod := newPod("fresh", map[string]string{
+			constant.AnnotationOperating:    "Delete",
+			constant.AnnotationOpsID:        "ops-1",
+			constant.AnnotationOpsStartTime: time.Now().Format(time.RFC3339Nano),
+		})
+		Expect(k8sClient.Create(ctx, pod)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("flags a Pod in the operating phase with a missing ops-start-time", func() {
+		check := &StuckLifecycleCheck{PodManager: podManager, Threshold: time.Minute}
+
+		ctx := context.TODO()
+		pod := newPod("no-start-time", map[string]string{
+			constant.AnnotationOperating: "Delete",
+			constant.AnnotationOpsID:     "ops-1",
+		})
+		Expect(k8sClient.Create(ctx, pod)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+		Expect(findings[0].Name).To(Equal("no-start-time"))
+		Expect(findings[0].Severity).To(Equal(SeverityWarning))
+	})
+
+	It("ignores Pods not in the operating phase", func() {
+		check := &StuckLifecycleCheck{PodManager: podManager, Threshold: time.Minute}
+
+		ctx := context.TODO()
+		pod := newPod("idle", nil)
+		Expect(k8sClient.Create(ctx, pod)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("defaults Threshold when zero", func() {
+		check := &StuckLifecycleCheck{PodManager: podManager}
+		Expect(check.Threshold).To(BeZero())
+
+		ctx := context.TODO()
+		pod := newPod("defaulted", map[string]string{
+			constant.AnnotationOperating:    "Delete",
+			constant.AnnotationOpsID:        "ops-1",
+			constant.AnnotationOpsStartTime: time.Now().Format(time.RFC3339Nano),
+		})
+		Expect(k8sClient.Create(ctx, pod)).NotTo(HaveOccurred())
+
+		findings, err := check.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+})
@@ -0,0 +1,51 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// ReportWriter persists a Report somewhere durable. In production this
+// writes a SpiderReport status update; tests can substitute an in-memory
+// fake.
+type ReportWriter interface {
+	WriteReport(ctx context.Context, report *Report) error
+}
+
+// PeriodicRunner runs a Sanitizer on a fixed interval and hands each Report
+// to a ReportWriter, so sanitize results stay fresh without a human
+// triggering `spiderctl sanitize` by hand. It implements manager.Runnable so
+// it can be added to a controller-runtime Manager alongside the rest of
+// Spiderpool's controllers.
+type PeriodicRunner struct {
+	Sanitizer *Sanitizer
+	Writer    ReportWriter
+	Interval  time.Duration
+}
+
+func (r *PeriodicRunner) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			report, err := r.Sanitizer.Run(ctx)
+			if err != nil {
+				return err
+			}
+			if err := r.Writer.WriteReport(ctx, report); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var _ manager.Runnable = (*PeriodicRunner)(nil)
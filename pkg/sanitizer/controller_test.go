@@ -0,0 +1,79 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type stubReportWriter struct {
+	reports []*Report
+	err     error
+}
+
+func (w *stubReportWriter) WriteReport(_ context.Context, report *Report) error {
+	w.reports = append(w.reports, report)
+	return w.err
+}
+
+var _ = Describe("PeriodicRunner", Label("controller_test"), func() {
+	It("runs the Sanitizer on every tick and hands the Report to the Writer", func() {
+		writer := &stubReportWriter{}
+		runner := &PeriodicRunner{
+			Sanitizer: NewSanitizer(&stubChecker{name: "a", findings: []Finding{{Check: "a"}}}),
+			Writer:    writer,
+			Interval:  time.Millisecond,
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+
+		Expect(runner.Start(ctx)).NotTo(HaveOccurred())
+		Expect(len(writer.reports)).To(BeNumerically(">=", 1))
+		Expect(writer.reports[0].Findings).To(HaveLen(1))
+	})
+
+	It("stops and returns the error when the Sanitizer fails", func() {
+		boom := errors.New("boom")
+		runner := &PeriodicRunner{
+			Sanitizer: NewSanitizer(&stubChecker{name: "a", err: boom}),
+			Writer:    &stubReportWriter{},
+			Interval:  time.Millisecond,
+		}
+
+		Expect(runner.Start(context.Background())).To(MatchError(boom))
+	})
+
+	It("stops and returns the error when the Writer fails", func() {
+		boom := errors.New("boom")
+		runner := &PeriodicRunner{
+			Sanitizer: NewSanitizer(&stubChecker{name: "a"}),
+			Writer:    &stubReportWriter{err: boom},
+			Interval:  time.Millisecond,
+		}
+
+		Expect(runner.Start(context.Background())).To(MatchError(boom))
+	})
+
+	It("returns nil when the context is cancelled before any tick fires", func() {
+		runner := &PeriodicRunner{
+			Sanitizer: NewSanitizer(&stubChecker{name: "a"}),
+			Writer:    &stubReportWriter{},
+			Interval:  time.Hour,
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		Expect(runner.Start(ctx)).NotTo(HaveOccurred())
+	})
+})
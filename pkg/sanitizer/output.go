@@ -0,0 +1,61 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+)
+
+// Format selects how WriteReport renders a Report.
+type Format string
+
+const (
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+	FormatTable Format = "table"
+)
+
+// WriteReport renders report to w in the requested format. An empty format
+// defaults to FormatTable.
+func WriteReport(w io.Writer, report *Report, format Format) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	case FormatYAML:
+		out, err := yaml.Marshal(report)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	case FormatTable, "":
+		return writeTable(w, report)
+	default:
+		return fmt.Errorf("%w: unknown output format %q", constant.ErrWrongInput, format)
+	}
+}
+
+func writeTable(w io.Writer, report *Report) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "CHECK\tSEVERITY\tNAMESPACE\tNAME\tMESSAGE")
+	for _, f := range report.Findings {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", f.Check, f.Severity, f.Namespace, f.Name, f.Message)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\nScore: %d/100 (%d findings)\n", report.Score, len(report.Findings))
+	return err
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+	"fmt"
+)
+
+// Sanitizer runs a fixed set of Checkers and aggregates their Findings into
+// a single Report. It is safe to reuse across runs; each Run call re-lists
+// cluster state fresh.
+type Sanitizer struct {
+	checkers []Checker
+}
+
+// NewSanitizer builds a Sanitizer over the given Checkers. Checker order is
+// preserved in Report.Findings.
+func NewSanitizer(checkers ...Checker) *Sanitizer {
+	return &Sanitizer{checkers: checkers}
+}
+
+// Run executes every Checker and returns the aggregate Report. A Checker
+// error aborts the whole run; partial results are never silently reported as
+// complete.
+func (s *Sanitizer) Run(ctx context.Context) (*Report, error) {
+	var findings []Finding
+
+	for _, checker := range s.checkers {
+		found, err := checker.Run(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("check %q failed: %w", checker.Name(), err)
+		}
+		findings = append(findings, found...)
+	}
+
+	return &Report{
+		Findings: findings,
+		Score:    scoreFor(findings),
+	}, nil
+}
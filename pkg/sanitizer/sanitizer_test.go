@@ -0,0 +1,66 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type stubChecker struct {
+	name     string
+	findings []Finding
+	err      error
+}
+
+func (s *stubChecker) Name() string { return s.name }
+
+func (s *stubChecker) Run(context.Context) ([]Finding, error) {
+	return s.findings, s.err
+}
+
+var _ = Describe("Sanitizer", Label("sanitizer_test"), func() {
+	Describe("Run", func() {
+		It("aggregates findings and score across checkers", func() {
+			s := NewSanitizer(
+				&stubChecker{name: "a", findings: []Finding{{Check: "a", Severity: SeverityError}}},
+				&stubChecker{name: "b", findings: []Finding{
+					{Check: "b", Severity: SeverityWarning},
+					{Check: "b", Severity: SeverityInfo},
+				}},
+			)
+
+			report, err := s.Run(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Findings).To(HaveLen(3))
+			// 100 - 10 (error) - 5 (warning) - 1 (info) = 84
+			Expect(report.Score).To(Equal(84))
+		})
+
+		It("stops on the first checker error", func() {
+			boom := errors.New("boom")
+			s := NewSanitizer(
+				&stubChecker{name: "a", err: boom},
+				&stubChecker{name: "b", findings: []Finding{{Check: "b"}}},
+			)
+
+			_, err := s.Run(context.Background())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("scoreFor", func() {
+		It("floors the score at 0", func() {
+			findings := make([]Finding, 15)
+			for i := range findings {
+				findings[i] = Finding{Severity: SeverityError}
+			}
+
+			Expect(scoreFor(findings)).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,22 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+)
+
+// runtimeScheme returns a Scheme with both the built-in Kubernetes types and
+// Spiderpool's own CRDs registered, for fake clients in this package's
+// tests.
+func runtimeScheme() *runtime.Scheme {
+	scheme := clientgoscheme.Scheme.DeepCopy()
+	if err := spiderpoolv2beta1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+	return scheme
+}
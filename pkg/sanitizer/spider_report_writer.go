@@ -0,0 +1,72 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+)
+
+// defaultSpiderReportName is the single cluster-scoped SpiderReport
+// PeriodicRunner keeps up to date; Spiderpool only runs one sanitizer
+// schedule per cluster.
+const defaultSpiderReportName = "default"
+
+// SpiderReportWriter is the production ReportWriter: it persists each Report
+// onto the cluster-scoped "default" SpiderReport, creating it on the first
+// run.
+type SpiderReportWriter struct {
+	Client client.Client
+}
+
+func (w *SpiderReportWriter) WriteReport(ctx context.Context, report *Report) error {
+	findings := make([]spiderpoolv2beta1.SpiderReportFinding, len(report.Findings))
+	for i, f := range report.Findings {
+		findings[i] = spiderpoolv2beta1.SpiderReportFinding{
+			Check:     f.Check,
+			Severity:  string(f.Severity),
+			Namespace: f.Namespace,
+			Name:      f.Name,
+			Message:   f.Message,
+		}
+	}
+	now := metav1.Now()
+
+	var existing spiderpoolv2beta1.SpiderReport
+	err := w.Client.Get(ctx, client.ObjectKey{Name: defaultSpiderReportName}, &existing)
+	if apierrors.IsNotFound(err) {
+		// SpiderReport has a status subresource, so the API server strips
+		// .status from a plain Create. Create the bare object first, then
+		// stamp the status through the subresource client.
+		created := &spiderpoolv2beta1.SpiderReport{
+			ObjectMeta: metav1.ObjectMeta{Name: defaultSpiderReportName},
+		}
+		if err := w.Client.Create(ctx, created); err != nil {
+			return err
+		}
+
+		created.Status = spiderpoolv2beta1.SpiderReportStatus{
+			Findings:    findings,
+			Score:       report.Score,
+			LastUpdated: now,
+		}
+		return w.Client.Status().Update(ctx, created)
+	}
+	if err != nil {
+		return err
+	}
+
+	existing.Status.Findings = findings
+	existing.Status.Score = report.Score
+	existing.Status.LastUpdated = now
+
+	return w.Client.Status().Update(ctx, &existing)
+}
+
+var _ ReportWriter = (*SpiderReportWriter)(nil)
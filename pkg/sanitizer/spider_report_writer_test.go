@@ -0,0 +1,61 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package sanitizer
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+)
+
+var _ = Describe("SpiderReportWriter", Label("spider_report_writer_test"), func() {
+	var scheme *runtime.Scheme
+	var fakeClient client.Client
+	var writer *SpiderReportWriter
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(spiderpoolv2beta1.AddToScheme(scheme)).To(Succeed())
+
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithStatusSubresource(&spiderpoolv2beta1.SpiderReport{}).
+			Build()
+		writer = &SpiderReportWriter{Client: fakeClient}
+	})
+
+	It("persists Status on the first write, even though SpiderReport has a status subresource", func() {
+		report := &Report{
+			Findings: []Finding{{Check: "a", Severity: SeverityError, Name: "pod-a"}},
+			Score:    90,
+		}
+
+		Expect(writer.WriteReport(context.TODO(), report)).To(Succeed())
+
+		var persisted spiderpoolv2beta1.SpiderReport
+		Expect(fakeClient.Get(context.TODO(), client.ObjectKey{Name: defaultSpiderReportName}, &persisted)).To(Succeed())
+		Expect(persisted.Status.Score).To(Equal(90))
+		Expect(persisted.Status.Findings).To(HaveLen(1))
+		Expect(persisted.Status.Findings[0].Name).To(Equal("pod-a"))
+	})
+
+	It("overwrites Status on subsequent writes", func() {
+		first := &Report{Findings: []Finding{{Check: "a"}}, Score: 90}
+		Expect(writer.WriteReport(context.TODO(), first)).To(Succeed())
+
+		second := &Report{Findings: []Finding{{Check: "b"}, {Check: "c"}}, Score: 70}
+		Expect(writer.WriteReport(context.TODO(), second)).To(Succeed())
+
+		var persisted spiderpoolv2beta1.SpiderReport
+		Expect(fakeClient.Get(context.TODO(), client.ObjectKey{Name: defaultSpiderReportName}, &persisted)).To(Succeed())
+		Expect(persisted.Status.Score).To(Equal(70))
+		Expect(persisted.Status.Findings).To(HaveLen(2))
+	})
+})
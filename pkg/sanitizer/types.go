@@ -0,0 +1,63 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sanitizer scans a live cluster for Spiderpool-specific
+// misconfigurations -- dangling IPAM annotations, orphaned SpiderEndpoints,
+// unmatched IPPool selectors, stuck lifecycle handoffs, and dead auto-pool
+// subnets -- in the spirit of Popeye's Kubernetes resource linting, but
+// scoped to this project's own CRDs.
+package sanitizer
+
+import "context"
+
+// Severity classifies how urgently a Finding should be acted on.
+type Severity string
+
+const (
+	SeverityInfo    Severity = "Info"
+	SeverityWarning Severity = "Warning"
+	SeverityError   Severity = "Error"
+)
+
+// Finding is a single misconfiguration reported by a Checker.
+type Finding struct {
+	Check     string   `json:"check" yaml:"check"`
+	Severity  Severity `json:"severity" yaml:"severity"`
+	Namespace string   `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Name      string   `json:"name" yaml:"name"`
+	Message   string   `json:"message" yaml:"message"`
+}
+
+// Report is the aggregate result of running every registered Checker.
+type Report struct {
+	Findings []Finding `json:"findings" yaml:"findings"`
+	// Score is a 0-100 aggregate health score: 100 minus a weighted
+	// penalty per Finding (Error costs more than Warning costs more than
+	// Info), floored at 0.
+	Score int `json:"score" yaml:"score"`
+}
+
+// Checker inspects one category of Spiderpool misconfiguration and returns
+// every instance it finds in the cluster.
+type Checker interface {
+	// Name identifies the check in Finding.Check and CLI/report output.
+	Name() string
+	Run(ctx context.Context) ([]Finding, error)
+}
+
+var severityPenalty = map[Severity]int{
+	SeverityError:   10,
+	SeverityWarning: 5,
+	SeverityInfo:    1,
+}
+
+func scoreFor(findings []Finding) int {
+	score := 100
+	for _, f := range findings {
+		score -= severityPenalty[f.Severity]
+	}
+	if score < 0 {
+		score = 0
+	}
+	return score
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package subnetmanager
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+)
+
+// SubnetManager exposes read operations against the SpiderSubnet API that
+// the rest of Spiderpool relies on, hiding the underlying controller-runtime
+// client.
+type SubnetManager interface {
+	ListSubnets(ctx context.Context, opts ...client.ListOption) (*spiderpoolv2beta1.SpiderSubnetList, error)
+}
+
+// SubnetManagerConfig holds tunables for SubnetManager. It is currently
+// empty; it exists so NewSubnetManager matches the constructor shape of the
+// rest of Spiderpool's managers.
+type SubnetManagerConfig struct{}
+
+type subnetManager struct {
+	config SubnetManagerConfig
+	client client.Client
+}
+
+func NewSubnetManager(c SubnetManagerConfig, client client.Client) (SubnetManager, error) {
+	if client == nil {
+		return nil, constant.ErrMissingRequiredParam
+	}
+
+	return &subnetManager{
+		config: c,
+		client: client,
+	}, nil
+}
+
+func (sm *subnetManager) ListSubnets(ctx context.Context, opts ...client.ListOption) (*spiderpoolv2beta1.SpiderSubnetList, error) {
+	var subnetList spiderpoolv2beta1.SpiderSubnetList
+	if err := sm.client.List(ctx, &subnetList, opts...); err != nil {
+		return nil, err
+	}
+
+	return &subnetList, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2022 Authors of spidernet-io
+// SPDX-License-Identifier: Apache-2.0
+
+package workloadendpointmanager
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/spidernet-io/spiderpool/pkg/constant"
+	spiderpoolv2beta1 "github.com/spidernet-io/spiderpool/pkg/k8s/apis/spiderpool.spidernet.io/v2beta1"
+)
+
+// WorkloadEndpointManager exposes read/write operations against the
+// SpiderEndpoint API that the rest of Spiderpool relies on, hiding the
+// underlying controller-runtime client.
+type WorkloadEndpointManager interface {
+	ListEndpoints(ctx context.Context, opts ...client.ListOption) (*spiderpoolv2beta1.SpiderEndpointList, error)
+	// ReleaseEndpoint deletes the SpiderEndpoint recording namespace/name's
+	// IP allocations. It is a no-op success if no such SpiderEndpoint
+	// exists, so callers can call it unconditionally when winding a Pod
+	// down.
+	ReleaseEndpoint(ctx context.Context, namespace, name string) error
+}
+
+// WorkloadEndpointManagerConfig holds tunables for WorkloadEndpointManager.
+// It is currently empty; it exists so NewWorkloadEndpointManager matches the
+// constructor shape of the rest of Spiderpool's managers.
+type WorkloadEndpointManagerConfig struct{}
+
+type workloadEndpointManager struct {
+	config WorkloadEndpointManagerConfig
+	client client.Client
+}
+
+func NewWorkloadEndpointManager(c WorkloadEndpointManagerConfig, client client.Client) (WorkloadEndpointManager, error) {
+	if client == nil {
+		return nil, constant.ErrMissingRequiredParam
+	}
+
+	return &workloadEndpointManager{
+		config: c,
+		client: client,
+	}, nil
+}
+
+func (wm *workloadEndpointManager) ListEndpoints(ctx context.Context, opts ...client.ListOption) (*spiderpoolv2beta1.SpiderEndpointList, error) {
+	var endpointList spiderpoolv2beta1.SpiderEndpointList
+	if err := wm.client.List(ctx, &endpointList, opts...); err != nil {
+		return nil, err
+	}
+
+	return &endpointList, nil
+}
+
+func (wm *workloadEndpointManager) ReleaseEndpoint(ctx context.Context, namespace, name string) error {
+	endpoint := &spiderpoolv2beta1.SpiderEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}
+
+	if err := wm.client.Delete(ctx, endpoint); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}